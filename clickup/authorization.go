@@ -0,0 +1,56 @@
+package clickup
+
+import (
+	"context"
+)
+
+type AuthorizedUserWrapper struct {
+	User AuthorizedUser `json:"user"`
+}
+
+// AuthorizedUser is the user the client's token belongs to.
+type AuthorizedUser struct {
+	ID                int64  `json:"id"`
+	Username          string `json:"username"`
+	Email             string `json:"email"`
+	Color             string `json:"color"`
+	ProfilePicture    string `json:"profilePicture"`
+	Initials          string `json:"initials"`
+	WeekStartDay      int    `json:"week_start_day"`
+	GlobalFontSupport bool   `json:"global_font_support"`
+	Timezone          string `json:"timezone"`
+}
+
+// AuthorizedUser returns the user the client is authenticated as.
+func (c *Client) AuthorizedUser(ctx context.Context) (*AuthorizedUser, *Response, error) {
+	req, err := c.NewRequest("GET", "user", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(AuthorizedUserWrapper)
+	resp, err := c.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &wResp.User, resp, nil
+}
+
+// AuthorizedTeams returns the workspaces the client's token has been
+// authorized for. With an OAuth token these are the workspaces the user
+// picked when installing the app.
+func (c *Client) AuthorizedTeams(ctx context.Context) (*WorkspacesWrapper, *Response, error) {
+	req, err := c.NewRequest("GET", "team", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(WorkspacesWrapper)
+	resp, err := c.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wResp, resp, nil
+}
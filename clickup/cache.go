@@ -0,0 +1,329 @@
+package clickup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// headerFromCache is set on responses served by CachingTransport. BareDo does
+// not update the rate limits from such responses.
+const headerFromCache = "X-From-Cache"
+
+// DefaultCacheTTLs are the TTLs used by CachingTransport when TTLs is nil.
+// They cover the structural endpoints that change rarely and are read on
+// every dashboard refresh.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"team":                  time.Hour,
+	"team/{id}/space":       10 * time.Minute,
+	"space/{id}":            10 * time.Minute,
+	"space/{id}/folder":     10 * time.Minute,
+	"space/{id}/list":       10 * time.Minute,
+	"space/{id}/tag":        10 * time.Minute,
+	"folder/{id}":           10 * time.Minute,
+	"folder/{id}/list":      10 * time.Minute,
+	"list/{id}":             10 * time.Minute,
+	"list/{id}/field":       10 * time.Minute,
+	"list/{id}/member":      10 * time.Minute,
+	"team/{id}/customroles": time.Hour,
+}
+
+// Cache stores serialized responses for CachingTransport. Keys are hex
+// encoded hashes and safe to use as file names.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte)
+	Delete(key string)
+}
+
+// MemoryCache is a Cache held in memory. The zero value is ready to use.
+type MemoryCache struct {
+	mu    sync.RWMutex
+	items map[string][]byte
+}
+
+// NewMemoryCache returns an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{}
+}
+
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	v, ok := c.items[key]
+	return v, ok
+}
+
+func (c *MemoryCache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.items == nil {
+		c.items = make(map[string][]byte)
+	}
+	c.items[key] = value
+}
+
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+}
+
+// DiskCache is a Cache storing one file per entry in Dir, so that it
+// survives restarts and can be shared by processes using the same token.
+// Entries contain response bodies and should be kept private.
+type DiskCache struct {
+	Dir string
+}
+
+// NewDiskCache returns a DiskCache in dir, creating it if needed.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &DiskCache{Dir: dir}, nil
+}
+
+func (c *DiskCache) Get(key string) ([]byte, bool) {
+	b, err := ioutil.ReadFile(filepath.Join(c.Dir, key))
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
+// Set writes the entry to a temporary file and renames it into place, so
+// that concurrent readers never see a partial entry. Errors are ignored,
+// which leaves the entry uncached.
+func (c *DiskCache) Set(key string, value []byte) {
+	f, err := ioutil.TempFile(c.Dir, key+".tmp*")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(value)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), filepath.Join(c.Dir, key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+func (c *DiskCache) Delete(key string) {
+	os.Remove(filepath.Join(c.Dir, key))
+}
+
+// CachingTransport caches successful GET responses of the endpoints listed
+// in TTLs. A fresh entry is served without a request and marked with the
+// X-From-Cache header. A stale entry with an ETag or Last-Modified header is
+// revalidated with a conditional request and served again on 304 Not
+// Modified. Any other request invalidates the cached reads of its URL and of
+// the collection above it, so that for example a PUT to space/{id}/tag/{name}
+// invalidates space/{id}/tag.
+//
+// The cache key includes a hash of the Authorization header, so
+// CachingTransport must run after the credentials are set, as the Transport
+// of PersonalTokenTransport or TokenTransport:
+//
+//	tp := &clickup.PersonalTokenTransport{
+//		PersonalToken: token,
+//		Transport:     &clickup.CachingTransport{Cache: clickup.NewMemoryCache()},
+//	}
+//	client := clickup.NewClient(tp.Client())
+type CachingTransport struct {
+	// Cache stores the responses. It defaults to a MemoryCache if nil.
+	Cache Cache
+
+	// TTLs maps endpoint templates relative to BasePath, such as
+	// "list/{id}/field", to how long their responses stay fresh. Endpoints
+	// not listed are not cached. It defaults to DefaultCacheTTLs if nil.
+	TTLs map[string]time.Duration
+
+	// BasePath is the path of the API base URL. It defaults to the path of
+	// the default base URL, "/api/v2/".
+	BasePath string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	defaultOnce  sync.Once
+	defaultCache *MemoryCache
+}
+
+// cacheEntry is the serialized form of a cached response.
+type cacheEntry struct {
+	Stored   time.Time `json:"stored"`
+	Response []byte    `json:"response"`
+}
+
+func (t *CachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		// A change through an endpoint makes its cached reads and those of
+		// the collection containing it stale. Reads of other endpoints
+		// listing the changed item expire with their TTL.
+		t.invalidate(req)
+		return t.transport().RoundTrip(req)
+	}
+	key := cacheKey(http.MethodGet, req.URL, req)
+
+	ttl, ok := t.ttl(req.URL)
+	if !ok || req.Method != http.MethodGet {
+		return t.transport().RoundTrip(req)
+	}
+
+	// Cache-Control: no-cache forces a revalidation of a fresh entry.
+	entry, cached := t.load(key, req)
+	if cached != nil && time.Now().Sub(entry.Stored) < ttl && req.Header.Get("Cache-Control") != "no-cache" {
+		return cached, nil
+	}
+
+	outReq := req
+	if cached != nil {
+		etag := cached.Header.Get("ETag")
+		lastModified := cached.Header.Get("Last-Modified")
+		if etag != "" || lastModified != "" {
+			outReq = req.Clone(req.Context())
+			if etag != "" {
+				outReq.Header.Set("If-None-Match", etag)
+			}
+			if lastModified != "" {
+				outReq.Header.Set("If-Modified-Since", lastModified)
+			}
+		}
+	}
+
+	resp, err := t.transport().RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+		// Keep the cached response and take only the validators from the
+		// 304, whose other headers may describe its empty body. It stays
+		// marked as cached, so BareDo does not record its old rate limits.
+		for _, k := range []string{"ETag", "Last-Modified"} {
+			if v := resp.Header.Get(k); v != "" {
+				cached.Header.Set(k, v)
+			}
+		}
+		cached.Header.Del(headerFromCache)
+		t.store(key, cached)
+		cached.Header.Set(headerFromCache, "1")
+		return cached, nil
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		t.store(key, resp)
+	}
+	return resp, nil
+}
+
+// Client returns an *http.Client using the transport.
+func (t *CachingTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// invalidate deletes the cached reads of the URL of req, with and without its
+// query, and of its parent collection.
+func (t *CachingTransport) invalidate(req *http.Request) {
+	u := *req.URL
+	t.cache().Delete(cacheKey(http.MethodGet, &u, req))
+	u.RawQuery = ""
+	t.cache().Delete(cacheKey(http.MethodGet, &u, req))
+	if i := strings.LastIndex(strings.TrimSuffix(u.Path, "/"), "/"); i > 0 {
+		u.Path, u.RawPath = u.Path[:i], ""
+		t.cache().Delete(cacheKey(http.MethodGet, &u, req))
+	}
+}
+
+// cache returns the Cache, or a MemoryCache of the transport if it is nil.
+func (t *CachingTransport) cache() Cache {
+	if t.Cache != nil {
+		return t.Cache
+	}
+	t.defaultOnce.Do(func() { t.defaultCache = NewMemoryCache() })
+	return t.defaultCache
+}
+
+// ttl returns the TTL of the endpoint of u and whether it is cached.
+func (t *CachingTransport) ttl(u *url.URL) (time.Duration, bool) {
+	ttls := t.TTLs
+	if ttls == nil {
+		ttls = DefaultCacheTTLs
+	}
+	basePath := t.BasePath
+	if basePath == "" {
+		basePath = "/api/v2/"
+	}
+	ttl, ok := ttls[templatePath(u.Path, basePath)]
+	return ttl, ok && ttl > 0
+}
+
+// store serializes resp into the cache, replacing resp.Body so that the
+// caller can still read it.
+func (t *CachingTransport) store(key string, resp *http.Response) {
+	b, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return
+	}
+	v, err := json.Marshal(cacheEntry{Stored: time.Now(), Response: b})
+	if err != nil {
+		return
+	}
+	t.cache().Set(key, v)
+}
+
+// load returns the cache entry for key and the response it holds, marked
+// with the X-From-Cache header, or a nil response if there is none.
+func (t *CachingTransport) load(key string, req *http.Request) (cacheEntry, *http.Response) {
+	var entry cacheEntry
+	b, ok := t.cache().Get(key)
+	if !ok {
+		return entry, nil
+	}
+	if err := json.Unmarshal(b, &entry); err != nil {
+		t.cache().Delete(key)
+		return entry, nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(entry.Response)), req)
+	if err != nil {
+		t.cache().Delete(key)
+		return entry, nil
+	}
+	resp.Header.Set(headerFromCache, "1")
+	return entry, resp
+}
+
+func (t *CachingTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+
+	return http.DefaultTransport
+}
+
+// cacheKey hashes the method, u and the credentials of req. Hashing keeps the
+// token out of the cache and keeps responses of different users apart.
+func cacheKey(method string, u *url.URL, req *http.Request) string {
+	token := sha256.Sum256([]byte(req.Header.Get(headerAuth)))
+	h := sha256.New()
+	h.Write([]byte(method + " " + u.String() + "\n"))
+	h.Write(token[:])
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,134 @@
+package clickup
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// cacheServer serves every GET with a body counting the GETs of its path,
+// tagged with an ETag that changes only when the path is written to. The
+// caller should close it.
+func cacheServer(t *testing.T) (*httptest.Server, map[string]int) {
+	t.Helper()
+	gets := make(map[string]int)
+	versions := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			versions[r.URL.Path]++
+			return
+		}
+		gets[r.URL.Path]++
+		etag := fmt.Sprintf(`"v%d"`, versions[r.URL.Path])
+		if r.Header.Get("If-None-Match") == etag {
+			w.Header().Set("Content-Type", "text/plain")
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		fmt.Fprintf(w, `{"gets":%d}`, gets[r.URL.Path])
+	}))
+	return srv, gets
+}
+
+func cacheGet(t *testing.T, c *http.Client, url string) (*http.Response, string) {
+	t.Helper()
+	resp, err := c.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(b)
+}
+
+func TestCachingTransport(t *testing.T) {
+	srv, gets := cacheServer(t)
+	defer srv.Close()
+	// No Cache set: the transport keeps its own in memory.
+	c := (&CachingTransport{}).Client()
+	tags := srv.URL + "/api/v2/space/1/tag"
+
+	if _, body := cacheGet(t, c, tags); body != `{"gets":1}` {
+		t.Errorf("first GET = %s", body)
+	}
+	resp, body := cacheGet(t, c, tags)
+	if body != `{"gets":1}` || resp.Header.Get(headerFromCache) == "" {
+		t.Errorf("second GET = %s, %s %q, want the cached response", body, headerFromCache, resp.Header.Get(headerFromCache))
+	}
+	if gets["/api/v2/space/1/tag"] != 1 {
+		t.Errorf("server saw %d GETs, want 1", gets["/api/v2/space/1/tag"])
+	}
+
+	// Uncached endpoints go to the server every time.
+	cacheGet(t, c, srv.URL+"/api/v2/task/1")
+	cacheGet(t, c, srv.URL+"/api/v2/task/1")
+	if gets["/api/v2/task/1"] != 2 {
+		t.Errorf("server saw %d GETs of an uncached endpoint, want 2", gets["/api/v2/task/1"])
+	}
+}
+
+func TestCachingTransportInvalidate(t *testing.T) {
+	tests := []struct {
+		method, path string
+	}{
+		{"POST", "/api/v2/space/1/tag"},
+		{"PUT", "/api/v2/space/1/tag/urgent"},
+		{"DELETE", "/api/v2/space/1/tag/urgent?custom=true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			srv, gets := cacheServer(t)
+			defer srv.Close()
+			c := (&CachingTransport{Cache: NewMemoryCache()}).Client()
+			tags := srv.URL + "/api/v2/space/1/tag"
+
+			cacheGet(t, c, tags)
+			req, _ := http.NewRequest(tt.method, srv.URL+tt.path, nil)
+			resp, err := c.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if _, body := cacheGet(t, c, tags); body != `{"gets":2}` {
+				t.Errorf("GET after %s %s = %s, want a fresh response", tt.method, tt.path, body)
+			}
+			if gets["/api/v2/space/1/tag"] != 2 {
+				t.Errorf("server saw %d GETs, want 2", gets["/api/v2/space/1/tag"])
+			}
+		})
+	}
+}
+
+func TestCachingTransportRevalidate(t *testing.T) {
+	srv, gets := cacheServer(t)
+	defer srv.Close()
+	c := (&CachingTransport{TTLs: map[string]time.Duration{"space/{id}/tag": time.Nanosecond}}).Client()
+	tags := srv.URL + "/api/v2/space/1/tag"
+
+	cacheGet(t, c, tags)
+	time.Sleep(time.Millisecond)
+	resp, body := cacheGet(t, c, tags)
+	if gets["/api/v2/space/1/tag"] != 2 {
+		t.Fatalf("server saw %d GETs, want a revalidation", gets["/api/v2/space/1/tag"])
+	}
+	if resp.StatusCode != http.StatusOK || body != `{"gets":1}` {
+		t.Errorf("revalidated GET = %d %s, want the cached body", resp.StatusCode, body)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want the cached one, not that of the 304", ct)
+	}
+	if etag := resp.Header.Get("ETag"); !strings.Contains(etag, "v0") {
+		t.Errorf("ETag = %q", etag)
+	}
+}
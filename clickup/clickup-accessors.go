@@ -0,0 +1,152 @@
+// Code generated by gen-accessors; DO NOT EDIT.
+// Instead, please run "go generate ./...".
+
+package clickup
+
+import (
+	"net/http"
+)
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *ErrorBlock) GetCreatedAt() Timestamp {
+	if e == nil || e.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.CreatedAt
+}
+
+// GetBlock returns the Block field.
+func (e *ErrorResponse) GetBlock() *ErrorBlock {
+	if e == nil {
+		return nil
+	}
+	return e.Block
+}
+
+// GetResponse returns the Response field.
+func (e *ErrorResponse) GetResponse() *http.Response {
+	if e == nil {
+		return nil
+	}
+	return e.Response
+}
+
+// GetMembers returns the Members field.
+func (g *GroupUpdate) GetMembers() *GroupMembersUpdate {
+	if g == nil {
+		return nil
+	}
+	return g.Members
+}
+
+// GetCanCreateViews returns the CanCreateViews field if it's non-nil, zero value otherwise.
+func (g *GuestRequest) GetCanCreateViews() bool {
+	if g == nil || g.CanCreateViews == nil {
+		return false
+	}
+	return *g.CanCreateViews
+}
+
+// GetCanEditTags returns the CanEditTags field if it's non-nil, zero value otherwise.
+func (g *GuestRequest) GetCanEditTags() bool {
+	if g == nil || g.CanEditTags == nil {
+		return false
+	}
+	return *g.CanEditTags
+}
+
+// GetCanSeeTimeEstimated returns the CanSeeTimeEstimated field if it's non-nil, zero value otherwise.
+func (g *GuestRequest) GetCanSeeTimeEstimated() bool {
+	if g == nil || g.CanSeeTimeEstimated == nil {
+		return false
+	}
+	return *g.CanSeeTimeEstimated
+}
+
+// GetCanSeeTimeSpent returns the CanSeeTimeSpent field if it's non-nil, zero value otherwise.
+func (g *GuestRequest) GetCanSeeTimeSpent() bool {
+	if g == nil || g.CanSeeTimeSpent == nil {
+		return false
+	}
+	return *g.CanSeeTimeSpent
+}
+
+// GetResponse returns the Response field.
+func (r *RateLimitError) GetResponse() *http.Response {
+	if r == nil {
+		return nil
+	}
+	return r.Response
+}
+
+// GetActionsRunnerRegistration returns the ActionsRunnerRegistration field.
+func (r *RateLimits) GetActionsRunnerRegistration() *Rate {
+	if r == nil {
+		return nil
+	}
+	return r.ActionsRunnerRegistration
+}
+
+// GetCodeScanningUpload returns the CodeScanningUpload field.
+func (r *RateLimits) GetCodeScanningUpload() *Rate {
+	if r == nil {
+		return nil
+	}
+	return r.CodeScanningUpload
+}
+
+// GetCore returns the Core field.
+func (r *RateLimits) GetCore() *Rate {
+	if r == nil {
+		return nil
+	}
+	return r.Core
+}
+
+// GetGraphQL returns the GraphQL field.
+func (r *RateLimits) GetGraphQL() *Rate {
+	if r == nil {
+		return nil
+	}
+	return r.GraphQL
+}
+
+// GetIntegrationManifest returns the IntegrationManifest field.
+func (r *RateLimits) GetIntegrationManifest() *Rate {
+	if r == nil {
+		return nil
+	}
+	return r.IntegrationManifest
+}
+
+// GetSCIM returns the SCIM field.
+func (r *RateLimits) GetSCIM() *Rate {
+	if r == nil {
+		return nil
+	}
+	return r.SCIM
+}
+
+// GetSearch returns the Search field.
+func (r *RateLimits) GetSearch() *Rate {
+	if r == nil {
+		return nil
+	}
+	return r.Search
+}
+
+// GetSourceImport returns the SourceImport field.
+func (r *RateLimits) GetSourceImport() *Rate {
+	if r == nil {
+		return nil
+	}
+	return r.SourceImport
+}
+
+// GetBillable returns the Billable field if it's non-nil, zero value otherwise.
+func (t *TimeEntryRequest) GetBillable() bool {
+	if t == nil || t.Billable == nil {
+		return false
+	}
+	return *t.Billable
+}
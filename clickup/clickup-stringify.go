@@ -0,0 +1,272 @@
+// Code generated by gen-stringify-test; DO NOT EDIT.
+// Instead, please run "go generate ./...".
+
+package clickup
+
+func (v AuthorizedUser) String() string {
+	return Stringify(v)
+}
+
+func (v AuthorizedUserWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v ChatViewComment) String() string {
+	return Stringify(v)
+}
+
+func (v ChatViewCommentsWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v CustomField) String() string {
+	return Stringify(v)
+}
+
+func (v CustomFieldsWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v CustomRole) String() string {
+	return Stringify(v)
+}
+
+func (v CustomRolesWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v EditUserRequest) String() string {
+	return Stringify(v)
+}
+
+func (v ErrorBlock) String() string {
+	return Stringify(v)
+}
+
+func (v Folder) String() string {
+	return Stringify(v)
+}
+
+func (v FoldersWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v Goal) String() string {
+	return Stringify(v)
+}
+
+func (v GoalsWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v Group) String() string {
+	return Stringify(v)
+}
+
+func (v GroupMembersUpdate) String() string {
+	return Stringify(v)
+}
+
+func (v GroupUpdate) String() string {
+	return Stringify(v)
+}
+
+func (v GroupsWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v Guest) String() string {
+	return Stringify(v)
+}
+
+func (v GuestRequest) String() string {
+	return Stringify(v)
+}
+
+func (v GuestWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v InviteUserRequest) String() string {
+	return Stringify(v)
+}
+
+func (v List) String() string {
+	return Stringify(v)
+}
+
+func (v ListComment) String() string {
+	return Stringify(v)
+}
+
+func (v ListCommentsWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v ListMember) String() string {
+	return Stringify(v)
+}
+
+func (v ListMembersWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v ListsWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v Member) String() string {
+	return Stringify(v)
+}
+
+func (v MemberWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v RateLimits) String() string {
+	return Stringify(v)
+}
+
+func (v SharedHierarchy) String() string {
+	return Stringify(v)
+}
+
+func (v Space) String() string {
+	return Stringify(v)
+}
+
+func (v SpacesWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v StatusHistory) String() string {
+	return Stringify(v)
+}
+
+func (v Tag) String() string {
+	return Stringify(v)
+}
+
+func (v TagsWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v Task) String() string {
+	return Stringify(v)
+}
+
+func (v TaskComment) String() string {
+	return Stringify(v)
+}
+
+func (v TaskCommentsWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v TaskDependency) String() string {
+	return Stringify(v)
+}
+
+func (v TaskDependencyOptions) String() string {
+	return Stringify(v)
+}
+
+func (v TaskLink) String() string {
+	return Stringify(v)
+}
+
+func (v TaskMember) String() string {
+	return Stringify(v)
+}
+
+func (v TaskMembersWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v TaskTemplate) String() string {
+	return Stringify(v)
+}
+
+func (v TaskTemplatesWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v TaskTimeInStatus) String() string {
+	return Stringify(v)
+}
+
+func (v TaskWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v TasksWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v TimeEntriesWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v TimeEntry) String() string {
+	return Stringify(v)
+}
+
+func (v TimeEntryHistory) String() string {
+	return Stringify(v)
+}
+
+func (v TimeEntryHistoryWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v TimeEntryTagsWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v TimeInterval) String() string {
+	return Stringify(v)
+}
+
+func (v TrackedTime) String() string {
+	return Stringify(v)
+}
+
+func (v TrackedTimeWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v View) String() string {
+	return Stringify(v)
+}
+
+func (v ViewWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v ViewsWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v Webhook) String() string {
+	return Stringify(v)
+}
+
+func (v WebhooksWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v Workspace) String() string {
+	return Stringify(v)
+}
+
+func (v WorkspaceSeats) String() string {
+	return Stringify(v)
+}
+
+func (v WorkspaceWrapper) String() string {
+	return Stringify(v)
+}
+
+func (v WorkspacesWrapper) String() string {
+	return Stringify(v)
+}
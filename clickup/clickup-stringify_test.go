@@ -0,0 +1,291 @@
+// Code generated by gen-stringify-test; DO NOT EDIT.
+// Instead, please run "go generate ./...".
+
+package clickup
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		in   fmt.Stringer
+		want []string
+	}{
+		{
+			AuthorizedUser{ID: 1, Username: "Username", Email: "Email", Color: "Color", ProfilePicture: "ProfilePicture", Initials: "Initials", WeekStartDay: 1, GlobalFontSupport: true, Timezone: "Timezone"},
+			[]string{`ID:1`, `Username:"Username"`, `Email:"Email"`, `Color:"Color"`, `ProfilePicture:"ProfilePicture"`, `Initials:"Initials"`, `WeekStartDay:1`, `GlobalFontSupport:true`, `Timezone:"Timezone"`},
+		},
+		{
+			AuthorizedUserWrapper{User: AuthorizedUser{}},
+			[]string{`User:clickup.AuthorizedUser{`},
+		},
+		{
+			ChatViewComment{ID: "ID", CommentText: "CommentText", Resolved: true, Date: "Date"},
+			[]string{`ID:"ID"`, `CommentText:"CommentText"`, `Resolved:true`, `Date:"Date"`},
+		},
+		{
+			ChatViewCommentsWrapper{Comments: []ChatViewComment{{}}},
+			[]string{`Comments:[clickup.ChatViewComment{`},
+		},
+		{
+			CustomField{ID: "ID", Name: "Name", Type: "Type", DateCreated: "DateCreated", HideFromGuests: true, Required: true},
+			[]string{`ID:"ID"`, `Name:"Name"`, `Type:"Type"`, `DateCreated:"DateCreated"`, `HideFromGuests:true`, `Required:true`},
+		},
+		{
+			CustomFieldsWrapper{Fields: []CustomField{{}}},
+			[]string{`Fields:[clickup.CustomField{`},
+		},
+		{
+			CustomRole{ID: 1, TeamID: "TeamID", InheritedRole: 1, DateCreated: "DateCreated", Members: []int64{1}},
+			[]string{`ID:1`, `TeamID:"TeamID"`, `InheritedRole:1`, `DateCreated:"DateCreated"`, `Members:[1]`},
+		},
+		{
+			CustomRolesWrapper{CustomRoles: []CustomRole{{}}},
+			[]string{`CustomRoles:[clickup.CustomRole{`},
+		},
+		{
+			EditUserRequest{Username: "Username", Admin: true, CustomRoleID: 1},
+			[]string{`Username:"Username"`, `Admin:true`, `CustomRoleID:1`},
+		},
+		{
+			ErrorBlock{Reason: "Reason", CreatedAt: &Timestamp{}},
+			[]string{`Reason:"Reason"`, `CreatedAt:clickup.Timestamp{`},
+		},
+		{
+			Folder{ID: "ID", Name: "Name", OrderIndex: 1, OverrideStatuses: true, Hidden: true, TaskCount: "TaskCount", Archived: true},
+			[]string{`ID:"ID"`, `Name:"Name"`, `OrderIndex:1`, `OverrideStatuses:true`, `Hidden:true`, `TaskCount:"TaskCount"`, `Archived:true`},
+		},
+		{
+			FoldersWrapper{Folders: []Folder{{}}},
+			[]string{`Folders:[clickup.Folder{`},
+		},
+		{
+			Goal{ID: "ID", Name: "Name", TeamID: "TeamID", DateCreated: "DateCreated", StartDate: "StartDate", DueDate: "DueDate", Description: "Description", Private: true, Archived: true, Creator: 1, Color: "Color", PrettyID: "PrettyID", MultipleOwners: true, FolderID: "FolderID", PercentCompleted: 1, PrettyUrl: "PrettyUrl"},
+			[]string{`ID:"ID"`, `Name:"Name"`, `TeamID:"TeamID"`, `DateCreated:"DateCreated"`, `StartDate:"StartDate"`, `DueDate:"DueDate"`, `Description:"Description"`, `Private:true`, `Archived:true`, `Creator:1`, `Color:"Color"`, `PrettyID:"PrettyID"`, `MultipleOwners:true`, `FolderID:"FolderID"`, `PercentCompleted:1`, `PrettyUrl:"PrettyUrl"`},
+		},
+		{
+			GoalsWrapper{Goals: []Goal{{}}},
+			[]string{`Goals:[clickup.Goal{`},
+		},
+		{
+			Group{ID: "ID", TeamID: "TeamID", UserID: 1, Name: "Name", Handle: "Handle", DateCreated: "DateCreated", Initials: "Initials"},
+			[]string{`ID:"ID"`, `TeamID:"TeamID"`, `UserID:1`, `Name:"Name"`, `Handle:"Handle"`, `DateCreated:"DateCreated"`, `Initials:"Initials"`},
+		},
+		{
+			GroupMembersUpdate{Add: []int64{1}, Remove: []int64{1}},
+			[]string{`Add:[1]`, `Remove:[1]`},
+		},
+		{
+			GroupUpdate{Name: "Name", Handle: "Handle", Members: &GroupMembersUpdate{}},
+			[]string{`Name:"Name"`, `Handle:"Handle"`, `Members:clickup.GroupMembersUpdate{`},
+		},
+		{
+			GroupsWrapper{Groups: []Group{{}}},
+			[]string{`Groups:[clickup.Group{`},
+		},
+		{
+			Guest{CanSeeTimeSpent: true, CanSeeTimeEstimated: true, CanEditTags: true, CanCreateViews: true},
+			[]string{`CanSeeTimeSpent:true`, `CanSeeTimeEstimated:true`, `CanEditTags:true`, `CanCreateViews:true`},
+		},
+		{
+			GuestRequest{Email: "Email", Username: "Username", CanEditTags: Bool(true), CanSeeTimeSpent: Bool(true), CanSeeTimeEstimated: Bool(true), CanCreateViews: Bool(true), CustomRoleID: 1},
+			[]string{`Email:"Email"`, `Username:"Username"`, `CanEditTags:true`, `CanSeeTimeSpent:true`, `CanSeeTimeEstimated:true`, `CanCreateViews:true`, `CustomRoleID:1`},
+		},
+		{
+			GuestWrapper{Guest: Guest{}},
+			[]string{`Guest:clickup.Guest{`},
+		},
+		{
+			InviteUserRequest{Email: "Email", Admin: true, CustomRoleID: 1},
+			[]string{`Email:"Email"`, `Admin:true`, `CustomRoleID:1`},
+		},
+		{
+			List{ID: "ID", Name: "Name", Deleted: true, OrderIndex: 1, Content: "Content", TaskCount: 1, DueDate: "DueDate", StartDate: "StartDate", InboundAddress: "InboundAddress", Archived: true, OverrideStatuses: true, PermissionLevel: "PermissionLevel"},
+			[]string{`ID:"ID"`, `Name:"Name"`, `Deleted:true`, `OrderIndex:1`, `Content:"Content"`, `TaskCount:1`, `DueDate:"DueDate"`, `StartDate:"StartDate"`, `InboundAddress:"InboundAddress"`, `Archived:true`, `OverrideStatuses:true`, `PermissionLevel:"PermissionLevel"`},
+		},
+		{
+			ListComment{ID: "ID", CommentText: "CommentText", Resolved: true, Date: "Date"},
+			[]string{`ID:"ID"`, `CommentText:"CommentText"`, `Resolved:true`, `Date:"Date"`},
+		},
+		{
+			ListCommentsWrapper{Comments: []ListComment{{}}},
+			[]string{`Comments:[clickup.ListComment{`},
+		},
+		{
+			ListMember{ID: 1, Username: "Username", Email: "Email", Color: "Color", Initials: "Initials", ProfilePicture: "ProfilePicture"},
+			[]string{`ID:1`, `Username:"Username"`, `Email:"Email"`, `Color:"Color"`, `Initials:"Initials"`, `ProfilePicture:"ProfilePicture"`},
+		},
+		{
+			ListMembersWrapper{Members: []ListMember{{}}},
+			[]string{`Members:[clickup.ListMember{`},
+		},
+		{
+			ListsWrapper{Lists: []List{{}}},
+			[]string{`Lists:[clickup.List{`},
+		},
+		{
+			MemberWrapper{Member: Member{}},
+			[]string{`Member:clickup.Member{`},
+		},
+		{
+			RateLimits{Core: &Rate{}, Search: &Rate{}, GraphQL: &Rate{}, IntegrationManifest: &Rate{}, SourceImport: &Rate{}, CodeScanningUpload: &Rate{}, ActionsRunnerRegistration: &Rate{}, SCIM: &Rate{}},
+			[]string{`Core:clickup.Rate{`, `Search:clickup.Rate{`, `GraphQL:clickup.Rate{`, `IntegrationManifest:clickup.Rate{`, `SourceImport:clickup.Rate{`, `CodeScanningUpload:clickup.Rate{`, `ActionsRunnerRegistration:clickup.Rate{`, `SCIM:clickup.Rate{`},
+		},
+		{
+			Space{ID: "ID", Name: "Name", Private: true, Color: "Color", Avatar: "Avatar", AdminCanManage: true, MultipleAssignees: true, Archived: true},
+			[]string{`ID:"ID"`, `Name:"Name"`, `Private:true`, `Color:"Color"`, `Avatar:"Avatar"`, `AdminCanManage:true`, `MultipleAssignees:true`, `Archived:true`},
+		},
+		{
+			SpacesWrapper{Spaces: []Space{{}}},
+			[]string{`Spaces:[clickup.Space{`},
+		},
+		{
+			StatusHistory{Status: "Status", Color: "Color", Type: "Type", OrderIndex: 1, Since: Timestamp{}},
+			[]string{`Status:"Status"`, `Color:"Color"`, `Type:"Type"`, `OrderIndex:1`, `Since:clickup.Timestamp{`},
+		},
+		{
+			Tag{Name: "Name", ForegroundColor: "ForegroundColor", BackgroundColor: "BackgroundColor", Creator: 1},
+			[]string{`Name:"Name"`, `ForegroundColor:"ForegroundColor"`, `BackgroundColor:"BackgroundColor"`, `Creator:1`},
+		},
+		{
+			TagsWrapper{Tags: []Tag{{}}},
+			[]string{`Tags:[clickup.Tag{`},
+		},
+		{
+			Task{ID: "ID", CustomID: "CustomID", Name: "Name", TextContent: "TextContent", Description: "Description", OrderIndex: "OrderIndex", DateCreated: "DateCreated", DateUpdated: "DateUpdated", DateClosed: "DateClosed", Tags: []Tag{{}}, DueDate: "DueDate", StartDate: "StartDate", Url: "Url", Dependencies: []TaskDependency{{}}, LinkedTasks: []TaskLink{{}}},
+			[]string{`ID:"ID"`, `CustomID:"CustomID"`, `Name:"Name"`, `TextContent:"TextContent"`, `Description:"Description"`, `OrderIndex:"OrderIndex"`, `DateCreated:"DateCreated"`, `DateUpdated:"DateUpdated"`, `DateClosed:"DateClosed"`, `Tags:[clickup.Tag{`, `DueDate:"DueDate"`, `StartDate:"StartDate"`, `Url:"Url"`, `Dependencies:[clickup.TaskDependency{`, `LinkedTasks:[clickup.TaskLink{`},
+		},
+		{
+			TaskComment{ID: "ID", CommentText: "CommentText", Resolved: true, Date: "Date"},
+			[]string{`ID:"ID"`, `CommentText:"CommentText"`, `Resolved:true`, `Date:"Date"`},
+		},
+		{
+			TaskCommentsWrapper{Comments: []TaskComment{{}}},
+			[]string{`Comments:[clickup.TaskComment{`},
+		},
+		{
+			TaskDependency{TaskID: "TaskID", DependsOn: "DependsOn", Type: 1, DateCreated: "DateCreated", UserID: "UserID"},
+			[]string{`TaskID:"TaskID"`, `DependsOn:"DependsOn"`, `Type:1`, `DateCreated:"DateCreated"`, `UserID:"UserID"`},
+		},
+		{
+			TaskDependencyOptions{DependsOn: "DependsOn", DependencyOf: "DependencyOf"},
+			[]string{`DependsOn:"DependsOn"`, `DependencyOf:"DependencyOf"`},
+		},
+		{
+			TaskLink{TaskID: "TaskID", LinkID: "LinkID", DateCreated: "DateCreated", UserID: "UserID"},
+			[]string{`TaskID:"TaskID"`, `LinkID:"LinkID"`, `DateCreated:"DateCreated"`, `UserID:"UserID"`},
+		},
+		{
+			TaskMember{ID: 1, Username: "Username", Email: "Email", Color: "Color", Initials: "Initials", ProfilePicture: "ProfilePicture"},
+			[]string{`ID:1`, `Username:"Username"`, `Email:"Email"`, `Color:"Color"`, `Initials:"Initials"`, `ProfilePicture:"ProfilePicture"`},
+		},
+		{
+			TaskMembersWrapper{Members: []TaskMember{{}}},
+			[]string{`Members:[clickup.TaskMember{`},
+		},
+		{
+			TaskTemplate{ID: "ID", Name: "Name"},
+			[]string{`ID:"ID"`, `Name:"Name"`},
+		},
+		{
+			TaskTemplatesWrapper{TaskTemplates: []TaskTemplate{{}}},
+			[]string{`TaskTemplates:[clickup.TaskTemplate{`},
+		},
+		{
+			TaskTimeInStatus{CurrentStatus: StatusHistory{}, StatusHistory: []StatusHistory{{}}},
+			[]string{`CurrentStatus:clickup.StatusHistory{`, `StatusHistory:[clickup.StatusHistory{`},
+		},
+		{
+			TaskWrapper{Task: Task{}},
+			[]string{`Task:clickup.Task{`},
+		},
+		{
+			TasksWrapper{Tasks: []Task{{}}, LastPage: true},
+			[]string{`Tasks:[clickup.Task{`, `LastPage:true`},
+		},
+		{
+			TimeEntriesWrapper{TimeEntries: []TimeEntry{{}}},
+			[]string{`TimeEntries:[clickup.TimeEntry{`},
+		},
+		{
+			TimeEntry{ID: "ID", WorkspaceID: "WorkspaceID", Billable: true, Start: Timestamp{}, End: Timestamp{}, Description: "Description", Tags: []Tag{{}}, Source: "Source", At: Timestamp{}, TaskTags: []Tag{{}}, TaskURL: "TaskURL"},
+			[]string{`ID:"ID"`, `WorkspaceID:"WorkspaceID"`, `Billable:true`, `Start:clickup.Timestamp{`, `End:clickup.Timestamp{`, `Description:"Description"`, `Tags:[clickup.Tag{`, `Source:"Source"`, `At:clickup.Timestamp{`, `TaskTags:[clickup.Tag{`, `TaskURL:"TaskURL"`},
+		},
+		{
+			TimeEntryHistory{ID: "ID", Field: "Field", Date: Timestamp{}, Source: "Source"},
+			[]string{`ID:"ID"`, `Field:"Field"`, `Date:clickup.Timestamp{`, `Source:"Source"`},
+		},
+		{
+			TimeEntryHistoryWrapper{History: []TimeEntryHistory{{}}},
+			[]string{`History:[clickup.TimeEntryHistory{`},
+		},
+		{
+			TimeEntryTagsWrapper{Tags: []Tag{{}}},
+			[]string{`Tags:[clickup.Tag{`},
+		},
+		{
+			TimeInterval{ID: "ID", Start: Timestamp{}, End: Timestamp{}, Source: "Source", DateAdded: Timestamp{}},
+			[]string{`ID:"ID"`, `Start:clickup.Timestamp{`, `End:clickup.Timestamp{`, `Source:"Source"`, `DateAdded:clickup.Timestamp{`},
+		},
+		{
+			TrackedTime{Intervals: []TimeInterval{{}}},
+			[]string{`Intervals:[clickup.TimeInterval{`},
+		},
+		{
+			TrackedTimeWrapper{TrackedTime: []TrackedTime{{}}},
+			[]string{`TrackedTime:[clickup.TrackedTime{`},
+		},
+		{
+			View{ID: "ID", Name: "Name", Type: "Type"},
+			[]string{`ID:"ID"`, `Name:"Name"`, `Type:"Type"`},
+		},
+		{
+			ViewWrapper{View: View{}},
+			[]string{`View:clickup.View{`},
+		},
+		{
+			ViewsWrapper{Views: []View{{}}},
+			[]string{`Views:[clickup.View{`},
+		},
+		{
+			Webhook{ID: "ID", UserId: 1, TeamID: 1, Endpoint: "Endpoint", ClientID: "ClientID", Events: []string{"Events"}, TaskID: "TaskID", ListID: "ListID", SpaceID: "SpaceID", Secret: "Secret"},
+			[]string{`ID:"ID"`, `UserId:1`, `TeamID:1`, `Endpoint:"Endpoint"`, `ClientID:"ClientID"`, `Events:["Events"]`, `TaskID:"TaskID"`, `ListID:"ListID"`, `SpaceID:"SpaceID"`, `Secret:"Secret"`},
+		},
+		{
+			WebhooksWrapper{Webhooks: []Webhook{{}}},
+			[]string{`Webhooks:[clickup.Webhook{`},
+		},
+		{
+			Workspace{ID: "ID", Name: "Name", Color: "Color", Avatar: "Avatar", Members: []Member{{}}},
+			[]string{`ID:"ID"`, `Name:"Name"`, `Color:"Color"`, `Avatar:"Avatar"`, `Members:[clickup.Member{`},
+		},
+		{
+			WorkspaceWrapper{Workspace: Workspace{}},
+			[]string{`Workspace:clickup.Workspace{`},
+		},
+		{
+			WorkspacesWrapper{Workspaces: []Workspace{{}}},
+			[]string{`Workspaces:[clickup.Workspace{`},
+		},
+	}
+
+	for _, tt := range tests {
+		got := tt.in.String()
+		prefix := fmt.Sprintf("%T{", tt.in)
+		if !strings.HasPrefix(got, prefix) {
+			t.Errorf("%T.String() = %v, want prefix %v", tt.in, got, prefix)
+			continue
+		}
+		if got != Stringify(tt.in) {
+			t.Errorf("%T.String() = %v, want Stringify output %v", tt.in, got, Stringify(tt.in))
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(got, "{"+want) && !strings.Contains(got, " "+want) {
+				t.Errorf("%T.String() = %v, missing %v", tt.in, got, want)
+			}
+		}
+	}
+}
@@ -14,8 +14,10 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -27,7 +29,7 @@ const (
 	headerRateLimit     = "X-RateLimit-Limit"
 	headerRateRemaining = "X-RateLimit-Remaining"
 	headerRateReset     = "X-RateLimit-Reset"
-	headerAuth          = "Authentication"
+	headerAuth          = "Authorization"
 )
 
 var errNonNilContext = errors.New("context must be non-nil")
@@ -45,17 +47,40 @@ type Client struct {
 	UserAgent string
 
 	rateMu sync.Mutex
+	rate   Rate // Rate limit for the client as determined by the most recent API call.
+
+	// Logger, when set, receives a debug record for every request and its
+	// response, and an error record for every request that fails.
+	Logger Logger
+
+	// OnRequest and OnResponse, when set, are called before every request is
+	// sent and after its response is received. See RequestInfo and
+	// ResponseInfo.
+	OnRequest  func(ctx context.Context, info *RequestInfo)
+	OnResponse func(ctx context.Context, info *ResponseInfo)
+
+	// Metrics records the latency and outcome of every request. NewClient
+	// sets it to NoopMetrics.
+	Metrics Metrics
+
+	// Tracer, when set, starts a span around every request. See Tracer and
+	// StartSpan.
+	Tracer Tracer
 
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the ClickUp API.
-	Workspaces *WorkspacesService
-	Spaces     *SpacesService
-	Folders    *FoldersService
-	Lists      *ListsService
-	Tasks      *TasksService
-	Groups     *GroupsService
-	Goals      *GoalsService
+	Workspaces   *WorkspacesService
+	Spaces       *SpacesService
+	Folders      *FoldersService
+	Lists        *ListsService
+	Tasks        *TasksService
+	Groups       *GroupsService
+	Goals        *GoalsService
+	TimeTracking *TimeTrackingService
+	Users        *UsersService
+	Guests       *GuestsService
+	Views        *ViewsService
 }
 
 type service struct {
@@ -118,7 +143,7 @@ func NewClient(httpClient *http.Client) *Client {
 	}
 	baseURL, _ := url.Parse(defaultBaseURL)
 
-	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent}
+	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent, Metrics: NoopMetrics{}}
 	c.common.client = c
 	c.Workspaces = (*WorkspacesService)(&c.common)
 	c.Spaces = (*SpacesService)(&c.common)
@@ -127,6 +152,10 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Tasks = (*TasksService)(&c.common)
 	c.Groups = (*GroupsService)(&c.common)
 	c.Goals = (*GoalsService)(&c.common)
+	c.TimeTracking = (*TimeTrackingService)(&c.common)
+	c.Users = (*UsersService)(&c.common)
+	c.Guests = (*GuestsService)(&c.common)
+	c.Views = (*ViewsService)(&c.common)
 	return c
 }
 
@@ -173,15 +202,44 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 
 type Response struct {
 	*http.Response
+
+	// Rate is the rate limit state reported with the response.
+	Rate Rate
 }
 
 // newResponse creates a new Response for the provided http.Response.
 // r must not be nil.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
+	response.Rate = parseRate(r)
 	return response
 }
 
+// parseRate parses the rate related headers.
+func parseRate(r *http.Response) Rate {
+	var rate Rate
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		if v, _ := strconv.ParseInt(reset, 10, 64); v != 0 {
+			rate.Reset = Timestamp{time.Unix(v, 0)}
+		}
+	}
+	return rate
+}
+
+// Rate returns the rate limit for the client as reported by the most recent
+// API call.
+func (c *Client) Rate() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rate
+}
+
 type requestContext uint8
 
 const (
@@ -196,13 +254,36 @@ const (
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is
 // canceled or times out, ctx.Err() will be returned.
-func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, error) {
+func (c *Client) BareDo(ctx context.Context, req *http.Request) (resp *Response, err error) {
 	if ctx == nil {
 		return nil, errNonNilContext
 	}
 
-	req.WithContext(ctx)
+	if c.Tracer != nil {
+		var endSpan func(*Response, error)
+		ctx, endSpan = c.startRequestSpan(ctx, req)
+		defer func() { endSpan(resp, err) }()
+	}
+
+	req = req.WithContext(ctx)
+
+	var info *RequestInfo
+	if c.observed() {
+		info = c.requestInfo(req)
+		c.beforeRequest(ctx, info)
+	}
+	start := time.Now()
+	resp, err = c.bareDo(ctx, req)
+	d := time.Since(start)
+	c.recordMetrics(req, resp, d)
+	if info != nil {
+		c.afterResponse(ctx, info, resp, err, d)
+	}
+	return resp, err
+}
 
+// bareDo is BareDo without the logging, hooks and metrics.
+func (c *Client) bareDo(ctx context.Context, req *http.Request) (*Response, error) {
 	resp, err := c.client.Do(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
@@ -227,9 +308,11 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 	response := newResponse(resp)
 
 	// Don't update the rate limits if this was a cached response.
-	// X-From-Cache is set by https://github.com/gregjones/httpcache
-	if response.Header.Get("X-From-Cache") == "" {
+	// X-From-Cache is set by CachingTransport and by
+	// https://github.com/gregjones/httpcache
+	if response.Header.Get(headerFromCache) == "" {
 		c.rateMu.Lock()
+		c.rate = response.Rate
 		c.rateMu.Unlock()
 	}
 
@@ -326,9 +409,9 @@ type ErrorBlock struct {
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %v %+v",
+	return Redact(fmt.Sprintf("%v %v: %d %v %+v",
 		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL),
-		r.Response.StatusCode, r.Message, r.Errors)
+		r.Response.StatusCode, r.Message, r.Errors))
 }
 
 // Is returns whether the provided error equals this error.
@@ -415,15 +498,27 @@ func (ae *AcceptedError) Is(target error) bool {
 	return bytes.Compare(ae.Raw, v.Raw) == 0
 }
 
-// sanitizeURL redacts the client_secret parameter from the URL which may be
-// exposed to the user.
+// sensitiveParams are the query parameters sanitizeURL redacts. They are
+// sent when exchanging an OAuth code for a token.
+var sensitiveParams = []string{"client_secret", "code"}
+
+// sanitizeURL returns a copy of the URL with the client_secret and code
+// parameters redacted, as it may be exposed to the user.
 func sanitizeURL(uri *url.URL) *url.URL {
 	if uri == nil {
 		return nil
 	}
+	sanitized := *uri
+	uri = &sanitized
 	params := uri.Query()
-	if len(params.Get("client_secret")) > 0 {
-		params.Set("client_secret", "REDACTED")
+	changed := false
+	for _, p := range sensitiveParams {
+		if len(params.Get(p)) > 0 {
+			params.Set(p, redacted)
+			changed = true
+		}
+	}
+	if changed {
 		uri.RawQuery = params.Encode()
 	}
 	return uri
@@ -437,8 +532,8 @@ type Error struct {
 }
 
 func (e *Error) Error() string {
-	return fmt.Sprintf("%v error caused by %v field on %v resource",
-		e.Code, e.Field, e.Resource)
+	return Redact(fmt.Sprintf("%v error caused by %v field on %v resource",
+		e.Code, e.Field, e.Resource))
 }
 
 func (e *Error) UnmarshalJSON(data []byte) error {
@@ -455,7 +550,8 @@ func (e *Error) UnmarshalJSON(data []byte) error {
 // API error responses are expected to have response
 // body, and a JSON response body that maps to ErrorResponse.
 //
-// The error type will be *RateLimitError for rate limit exceeded errors,
+// The error type will be *RateLimitError for rate limit exceeded errors
+// (429 Too Many Requests, or 403 Forbidden with no requests remaining),
 // *AcceptedError for 202 Accepted status codes,
 // and *TwoFactorAuthError for two-factor authentication errors.
 func CheckResponse(r *http.Response) error {
@@ -473,9 +569,10 @@ func CheckResponse(r *http.Response) error {
 	}
 	r.Body = ioutil.NopCloser(bytes.NewBuffer(data))
 	switch {
-	case r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
+	case r.StatusCode == http.StatusTooManyRequests,
+		r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
 		return &RateLimitError{
-			Rate:     Rate{}, //Need to do this right ;) parseRate(r),
+			Rate:     parseRate(r),
 			Response: errorResponse.Response,
 			Message:  errorResponse.Message,
 		}
@@ -551,7 +648,7 @@ func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error)
 	return response.Resources, resp, nil
 }
 
-func setCredentialsAsHeaders(req *http.Request, personalToken string) *http.Request {
+func setCredentialsAsHeaders(req *http.Request, credential string) *http.Request {
 	// To set extra headers, we must make a copy of the Request so
 	// that we don't modify the Request we were given. This is required by the
 	// specification of http.RoundTripper.
@@ -565,7 +662,7 @@ func setCredentialsAsHeaders(req *http.Request, personalToken string) *http.Requ
 	for k, s := range req.Header {
 		convertedRequest.Header[k] = append([]string(nil), s...)
 	}
-	convertedRequest.Header.Set("Authorization", personalToken)
+	convertedRequest.Header.Set(headerAuth, credential)
 	return convertedRequest
 }
 
@@ -587,6 +684,17 @@ func (t *PersonalTokenTransport) Client() *http.Client {
 	return &http.Client{Transport: t}
 }
 
+// String implements fmt.Stringer so that printing the transport, for example
+// with %+v, does not leak the token.
+func (t PersonalTokenTransport) String() string {
+	return "PersonalTokenTransport{PersonalToken:" + redacted + "}"
+}
+
+// GoString implements fmt.GoStringer, redacting the token like String.
+func (t PersonalTokenTransport) GoString() string {
+	return t.String()
+}
+
 func (t *PersonalTokenTransport) transport() http.RoundTripper {
 	if t.Transport != nil {
 		return t.Transport
@@ -595,6 +703,45 @@ func (t *PersonalTokenTransport) transport() http.RoundTripper {
 	return http.DefaultTransport
 }
 
+// TokenTransport authenticates requests with an OAuth access token, as
+// returned by the oauth package.
+type TokenTransport struct {
+	AccessToken string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+func (t *TokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := setCredentialsAsHeaders(req, "Bearer "+t.AccessToken)
+
+	return t.transport().RoundTrip(req2)
+}
+
+func (t *TokenTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// String implements fmt.Stringer so that printing the transport, for example
+// with %+v, does not leak the token.
+func (t TokenTransport) String() string {
+	return "TokenTransport{AccessToken:" + redacted + "}"
+}
+
+// GoString implements fmt.GoStringer, redacting the token like String.
+func (t TokenTransport) GoString() string {
+	return t.String()
+}
+
+func (t *TokenTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+
+	return http.DefaultTransport
+}
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool { return &v }
@@ -0,0 +1,83 @@
+// Package clickupmock provides mocks of the service interfaces of package
+// clickup, for testing code that uses a clickup.API without an HTTP server.
+//
+// Every mock method calls the function field of the same name with a Func
+// suffix, after recording the call:
+//
+//	m := clickupmock.New()
+//	m.Tasks.GetFunc = func(ctx context.Context, taskID string, query string) (*clickup.Task, *clickup.Response, error) {
+//		return &clickup.Task{ID: taskID, Name: "Write tests"}, nil, nil
+//	}
+//	run(m.API())
+//	if calls := m.Tasks.CallsTo("Get"); len(calls) != 1 {
+//		t.Errorf("Get called %d times", len(calls))
+//	}
+//
+// A method whose function is nil returns zero values and a *NotStubbedError.
+//
+// The mocks are generated from the interfaces in the clickup package by
+// gen-mocks.go.
+package clickupmock
+
+//go:generate go run gen-mocks.go
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Call is a recorded call of a mock method. Args holds the arguments after
+// the context, with variadic arguments as a slice.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// recorder records the calls of a mock.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls made to the mock, in order.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to the method of the mock, in order.
+func (r *recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// ResetCalls forgets the calls recorded so far.
+func (r *recorder) ResetCalls() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// NotStubbedError is returned by a mock method whose function is not set.
+type NotStubbedError struct {
+	API    string
+	Method string
+}
+
+func (e *NotStubbedError) Error() string {
+	return fmt.Sprintf("clickupmock: %s.%s called but %sFunc is not set", e.API, e.Method, e.Method)
+}
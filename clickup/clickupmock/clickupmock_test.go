@@ -0,0 +1,58 @@
+package clickupmock_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickupmock"
+)
+
+func TestMock(t *testing.T) {
+	m := clickupmock.New()
+	m.Tasks.GetFunc = func(ctx context.Context, taskID string, query string) (*clickup.Task, *clickup.Response, error) {
+		return &clickup.Task{ID: taskID}, nil, nil
+	}
+	api := m.API()
+
+	task, _, err := api.Tasks.Get(context.Background(), "86abc", "")
+	if err != nil || task.ID != "86abc" {
+		t.Errorf("Get = %+v, %v", task, err)
+	}
+	_, err = api.Tasks.AddTag(context.Background(), "86abc", "urgent", "")
+	var nse *clickupmock.NotStubbedError
+	if !errors.As(err, &nse) || nse.Method != "AddTag" {
+		t.Errorf("AddTag error = %v, want *NotStubbedError", err)
+	}
+
+	want := []clickupmock.Call{{Method: "Get", Args: []interface{}{"86abc", ""}}}
+	if got := m.Tasks.CallsTo("Get"); !reflect.DeepEqual(got, want) {
+		t.Errorf("CallsTo(Get) = %+v, want %+v", got, want)
+	}
+	if n := len(m.Tasks.Calls()); n != 2 {
+		t.Errorf("%d calls recorded, want 2", n)
+	}
+	m.Tasks.ResetCalls()
+	if n := len(m.Tasks.Calls()); n != 0 {
+		t.Errorf("%d calls after ResetCalls", n)
+	}
+}
+
+// TestAPIComplete checks that every service is wired into the API facades of
+// the client and of the mocks.
+func TestAPIComplete(t *testing.T) {
+	for name, api := range map[string]*clickup.API{
+		"Client.API":      clickup.NewClient(nil).API(),
+		"clickupmock.New": clickupmock.New().API(),
+	} {
+		v := reflect.ValueOf(api).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			// A nil service is a non-nil interface holding a nil pointer.
+			if f := v.Field(i); f.IsNil() || f.Elem().IsNil() {
+				t.Errorf("%s leaves %s nil", name, v.Type().Field(i).Name)
+			}
+		}
+	}
+}
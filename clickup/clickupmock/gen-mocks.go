@@ -0,0 +1,287 @@
+//go:build ignore
+// +build ignore
+
+// gen-mocks generates mocks.go with a mock of every interface ending in API
+// declared in ../interfaces.go, and a Client holding one mock per field of
+// the clickup.API struct. It is run by go generate.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+var (
+	source = flag.String("source", "../interfaces.go", "file declaring the interfaces")
+	output = flag.String("output", "mocks.go", "file to write")
+)
+
+type param struct {
+	Name     string
+	Type     string // as declared in a function type, e.g. "...string"
+	Variadic bool
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []string
+	// Context is whether the first parameter is a context.Context, which is
+	// not recorded.
+	Context bool
+}
+
+type mock struct {
+	Name    string
+	Methods []method
+}
+
+type field struct {
+	Name string
+	Type string
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-mocks: ")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, *source, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var mocks []mock
+	var fields []field
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			switch t := ts.Type.(type) {
+			case *ast.InterfaceType:
+				if strings.HasSuffix(ts.Name.Name, "API") {
+					mocks = append(mocks, newMock(fset, ts.Name.Name, t))
+				}
+			case *ast.StructType:
+				if ts.Name.Name == "API" {
+					for _, fl := range t.Fields.List {
+						for _, n := range fl.Names {
+							fields = append(fields, field{Name: n.Name, Type: typeString(fset, fl.Type)})
+						}
+					}
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct {
+		Mocks  []mock
+		Fields []field
+	}{mocks, fields}); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated code: %v\n%s", err, buf.Bytes())
+	}
+	if err := ioutil.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newMock(fset *token.FileSet, name string, it *ast.InterfaceType) mock {
+	m := mock{Name: name}
+	for _, fl := range it.Methods.List {
+		ft, ok := fl.Type.(*ast.FuncType)
+		if !ok || len(fl.Names) == 0 {
+			log.Fatalf("%s: embedded interfaces are not supported", name)
+		}
+		meth := method{Name: fl.Names[0].Name}
+		for i, p := range ft.Params.List {
+			typ := typeString(fset, qualify(p.Type))
+			_, variadic := p.Type.(*ast.Ellipsis)
+			if i == 0 && typ == "context.Context" {
+				meth.Context = true
+			}
+			names := p.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("a%d", len(meth.Params)))}
+			}
+			for _, n := range names {
+				meth.Params = append(meth.Params, param{Name: n.Name, Type: typ, Variadic: variadic})
+			}
+		}
+		if ft.Results != nil {
+			for _, r := range ft.Results.List {
+				n := len(r.Names)
+				if n == 0 {
+					n = 1
+				}
+				for i := 0; i < n; i++ {
+					meth.Results = append(meth.Results, typeString(fset, qualify(r.Type)))
+				}
+			}
+		}
+		m.Methods = append(m.Methods, meth)
+	}
+	return m
+}
+
+// qualify returns expr with the exported identifiers of package clickup
+// prefixed by the package name.
+func qualify(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if unicode.IsUpper(rune(e.Name[0])) {
+			return &ast.SelectorExpr{X: ast.NewIdent("clickup"), Sel: ast.NewIdent(e.Name)}
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key), Value: qualify(e.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(e.Elt)}
+	}
+	return expr
+}
+
+func typeString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+var tmpl = template.Must(template.New("mocks").Funcs(template.FuncMap{
+	"params": func(ps []param) string {
+		s := make([]string, len(ps))
+		for i, p := range ps {
+			s[i] = p.Name + " " + p.Type
+		}
+		return strings.Join(s, ", ")
+	},
+	"args": func(ps []param) string {
+		s := make([]string, len(ps))
+		for i, p := range ps {
+			s[i] = p.Name
+			if p.Variadic {
+				s[i] += "..."
+			}
+		}
+		return strings.Join(s, ", ")
+	},
+	"recorded": func(m method) string {
+		ps := m.Params
+		if m.Context {
+			ps = ps[1:]
+		}
+		s := []string{fmt.Sprintf("%q", m.Name)}
+		for _, p := range ps {
+			s = append(s, p.Name)
+		}
+		return strings.Join(s, ", ")
+	},
+	"results": func(rs []string) string {
+		if len(rs) == 1 {
+			return rs[0]
+		}
+		return "(" + strings.Join(rs, ", ") + ")"
+	},
+	"zeros": func(rs []string) []string {
+		if len(rs) > 0 && rs[len(rs)-1] == "error" {
+			return rs[:len(rs)-1]
+		}
+		return rs
+	},
+	"returnsError": func(rs []string) bool {
+		return len(rs) > 0 && rs[len(rs)-1] == "error"
+	},
+	"zeroNames": func(rs []string, withErr bool, api, name string) string {
+		var s []string
+		for i := range rs {
+			s = append(s, fmt.Sprintf("r%d", i))
+		}
+		if withErr {
+			s = append(s, fmt.Sprintf("&NotStubbedError{API: %q, Method: %q}", api, name))
+		}
+		return strings.Join(s, ", ")
+	},
+}).Parse(`// Code generated by gen-mocks; DO NOT EDIT.
+// Instead, please run "go generate ./...".
+
+package clickupmock
+
+import (
+	"context"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Client holds a mock of every service of a clickup.API.
+type Client struct {
+{{- range .Fields}}
+	{{.Name}} *{{.Type}}
+{{- end}}
+}
+
+// New returns a Client with new mocks.
+func New() *Client {
+	return &Client{
+{{- range .Fields}}
+		{{.Name}}: new({{.Type}}),
+{{- end}}
+	}
+}
+
+// API returns the mocks as a clickup.API.
+func (c *Client) API() *clickup.API {
+	return &clickup.API{
+{{- range .Fields}}
+		{{.Name}}: c.{{.Name}},
+{{- end}}
+	}
+}
+{{range $m := .Mocks}}
+// {{.Name}} is a mock of clickup.{{.Name}}.
+type {{.Name}} struct {
+	recorder
+{{range .Methods}}
+	{{.Name}}Func func({{params .Params}}) {{results .Results}}
+{{- end}}
+}
+
+var _ clickup.{{.Name}} = (*{{.Name}})(nil)
+{{range .Methods}}
+// {{.Name}} records the call and calls {{.Name}}Func.
+func (m *{{$m.Name}}) {{.Name}}({{params .Params}}) {{results .Results}} {
+	m.record({{recorded .}})
+	if m.{{.Name}}Func == nil {
+{{- $zeros := zeros .Results}}
+{{- range $i, $t := $zeros}}
+		var r{{$i}} {{$t}}
+{{- end}}
+		return {{zeroNames $zeros (returnsError .Results) $m.Name .Name}}
+	}
+	return m.{{.Name}}Func({{args .Params}})
+}
+{{end}}{{end}}`))
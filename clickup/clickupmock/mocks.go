@@ -0,0 +1,1152 @@
+// Code generated by gen-mocks; DO NOT EDIT.
+// Instead, please run "go generate ./...".
+
+package clickupmock
+
+import (
+	"context"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Client holds a mock of every service of a clickup.API.
+type Client struct {
+	Workspaces   *WorkspacesAPI
+	Spaces       *SpacesAPI
+	Folders      *FoldersAPI
+	Lists        *ListsAPI
+	Tasks        *TasksAPI
+	Groups       *GroupsAPI
+	Goals        *GoalsAPI
+	TimeTracking *TimeTrackingAPI
+	Users        *UsersAPI
+	Guests       *GuestsAPI
+	Views        *ViewsAPI
+}
+
+// New returns a Client with new mocks.
+func New() *Client {
+	return &Client{
+		Workspaces:   new(WorkspacesAPI),
+		Spaces:       new(SpacesAPI),
+		Folders:      new(FoldersAPI),
+		Lists:        new(ListsAPI),
+		Tasks:        new(TasksAPI),
+		Groups:       new(GroupsAPI),
+		Goals:        new(GoalsAPI),
+		TimeTracking: new(TimeTrackingAPI),
+		Users:        new(UsersAPI),
+		Guests:       new(GuestsAPI),
+		Views:        new(ViewsAPI),
+	}
+}
+
+// API returns the mocks as a clickup.API.
+func (c *Client) API() *clickup.API {
+	return &clickup.API{
+		Workspaces:   c.Workspaces,
+		Spaces:       c.Spaces,
+		Folders:      c.Folders,
+		Lists:        c.Lists,
+		Tasks:        c.Tasks,
+		Groups:       c.Groups,
+		Goals:        c.Goals,
+		TimeTracking: c.TimeTracking,
+		Users:        c.Users,
+		Guests:       c.Guests,
+		Views:        c.Views,
+	}
+}
+
+// WorkspacesAPI is a mock of clickup.WorkspacesAPI.
+type WorkspacesAPI struct {
+	recorder
+
+	GetFunc              func(ctx context.Context) (*clickup.WorkspacesWrapper, *clickup.Response, error)
+	GetSeatsFunc         func(ctx context.Context, workspaceId string) (*clickup.WorkspaceSeats, *clickup.Response, error)
+	CustomRolesFunc      func(ctx context.Context, workspaceId string, query string) (*clickup.CustomRolesWrapper, *clickup.Response, error)
+	TaskTemplatesFunc    func(ctx context.Context, workspaceId string, query string) (*clickup.TaskTemplatesWrapper, *clickup.Response, error)
+	AllTaskTemplatesFunc func(ctx context.Context, workspaceId string) (*clickup.TaskTemplatesWrapper, *clickup.Response, error)
+	WebhooksFunc         func(ctx context.Context, workspaceId string, query string) (*clickup.WebhooksWrapper, *clickup.Response, error)
+	SharedHierarchyFunc  func(ctx context.Context, workspaceId string, query string) (*clickup.SharedHierarchy, *clickup.Response, error)
+	ViewsFunc            func(ctx context.Context, workspaceID string, query string) (*clickup.ViewsWrapper, *clickup.Response, error)
+}
+
+var _ clickup.WorkspacesAPI = (*WorkspacesAPI)(nil)
+
+// Get records the call and calls GetFunc.
+func (m *WorkspacesAPI) Get(ctx context.Context) (*clickup.WorkspacesWrapper, *clickup.Response, error) {
+	m.record("Get")
+	if m.GetFunc == nil {
+		var r0 *clickup.WorkspacesWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "WorkspacesAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx)
+}
+
+// GetSeats records the call and calls GetSeatsFunc.
+func (m *WorkspacesAPI) GetSeats(ctx context.Context, workspaceId string) (*clickup.WorkspaceSeats, *clickup.Response, error) {
+	m.record("GetSeats", workspaceId)
+	if m.GetSeatsFunc == nil {
+		var r0 *clickup.WorkspaceSeats
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "WorkspacesAPI", Method: "GetSeats"}
+	}
+	return m.GetSeatsFunc(ctx, workspaceId)
+}
+
+// CustomRoles records the call and calls CustomRolesFunc.
+func (m *WorkspacesAPI) CustomRoles(ctx context.Context, workspaceId string, query string) (*clickup.CustomRolesWrapper, *clickup.Response, error) {
+	m.record("CustomRoles", workspaceId, query)
+	if m.CustomRolesFunc == nil {
+		var r0 *clickup.CustomRolesWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "WorkspacesAPI", Method: "CustomRoles"}
+	}
+	return m.CustomRolesFunc(ctx, workspaceId, query)
+}
+
+// TaskTemplates records the call and calls TaskTemplatesFunc.
+func (m *WorkspacesAPI) TaskTemplates(ctx context.Context, workspaceId string, query string) (*clickup.TaskTemplatesWrapper, *clickup.Response, error) {
+	m.record("TaskTemplates", workspaceId, query)
+	if m.TaskTemplatesFunc == nil {
+		var r0 *clickup.TaskTemplatesWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "WorkspacesAPI", Method: "TaskTemplates"}
+	}
+	return m.TaskTemplatesFunc(ctx, workspaceId, query)
+}
+
+// AllTaskTemplates records the call and calls AllTaskTemplatesFunc.
+func (m *WorkspacesAPI) AllTaskTemplates(ctx context.Context, workspaceId string) (*clickup.TaskTemplatesWrapper, *clickup.Response, error) {
+	m.record("AllTaskTemplates", workspaceId)
+	if m.AllTaskTemplatesFunc == nil {
+		var r0 *clickup.TaskTemplatesWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "WorkspacesAPI", Method: "AllTaskTemplates"}
+	}
+	return m.AllTaskTemplatesFunc(ctx, workspaceId)
+}
+
+// Webhooks records the call and calls WebhooksFunc.
+func (m *WorkspacesAPI) Webhooks(ctx context.Context, workspaceId string, query string) (*clickup.WebhooksWrapper, *clickup.Response, error) {
+	m.record("Webhooks", workspaceId, query)
+	if m.WebhooksFunc == nil {
+		var r0 *clickup.WebhooksWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "WorkspacesAPI", Method: "Webhooks"}
+	}
+	return m.WebhooksFunc(ctx, workspaceId, query)
+}
+
+// SharedHierarchy records the call and calls SharedHierarchyFunc.
+func (m *WorkspacesAPI) SharedHierarchy(ctx context.Context, workspaceId string, query string) (*clickup.SharedHierarchy, *clickup.Response, error) {
+	m.record("SharedHierarchy", workspaceId, query)
+	if m.SharedHierarchyFunc == nil {
+		var r0 *clickup.SharedHierarchy
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "WorkspacesAPI", Method: "SharedHierarchy"}
+	}
+	return m.SharedHierarchyFunc(ctx, workspaceId, query)
+}
+
+// Views records the call and calls ViewsFunc.
+func (m *WorkspacesAPI) Views(ctx context.Context, workspaceID string, query string) (*clickup.ViewsWrapper, *clickup.Response, error) {
+	m.record("Views", workspaceID, query)
+	if m.ViewsFunc == nil {
+		var r0 *clickup.ViewsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "WorkspacesAPI", Method: "Views"}
+	}
+	return m.ViewsFunc(ctx, workspaceID, query)
+}
+
+// SpacesAPI is a mock of clickup.SpacesAPI.
+type SpacesAPI struct {
+	recorder
+
+	GetFunc       func(ctx context.Context, spaceID string, query string) (*clickup.Space, *clickup.Response, error)
+	ListFunc      func(ctx context.Context, workspaceID string, query string) (*clickup.SpacesWrapper, *clickup.Response, error)
+	TagsFunc      func(ctx context.Context, spaceID string, query string) (*clickup.TagsWrapper, *clickup.Response, error)
+	ViewsFunc     func(ctx context.Context, spaceID string, query string) (*clickup.ViewsWrapper, *clickup.Response, error)
+	CreateTagFunc func(ctx context.Context, spaceID string, tag clickup.Tag) (*clickup.Response, error)
+	EditTagFunc   func(ctx context.Context, spaceID string, tagName string, tag clickup.Tag) (*clickup.Response, error)
+	DeleteTagFunc func(ctx context.Context, spaceID string, tagName string) (*clickup.Response, error)
+	SyncTagsFunc  func(ctx context.Context, spaceID string, desired []clickup.Tag) (*clickup.TagSyncResult, *clickup.Response, error)
+}
+
+var _ clickup.SpacesAPI = (*SpacesAPI)(nil)
+
+// Get records the call and calls GetFunc.
+func (m *SpacesAPI) Get(ctx context.Context, spaceID string, query string) (*clickup.Space, *clickup.Response, error) {
+	m.record("Get", spaceID, query)
+	if m.GetFunc == nil {
+		var r0 *clickup.Space
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "SpacesAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx, spaceID, query)
+}
+
+// List records the call and calls ListFunc.
+func (m *SpacesAPI) List(ctx context.Context, workspaceID string, query string) (*clickup.SpacesWrapper, *clickup.Response, error) {
+	m.record("List", workspaceID, query)
+	if m.ListFunc == nil {
+		var r0 *clickup.SpacesWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "SpacesAPI", Method: "List"}
+	}
+	return m.ListFunc(ctx, workspaceID, query)
+}
+
+// Tags records the call and calls TagsFunc.
+func (m *SpacesAPI) Tags(ctx context.Context, spaceID string, query string) (*clickup.TagsWrapper, *clickup.Response, error) {
+	m.record("Tags", spaceID, query)
+	if m.TagsFunc == nil {
+		var r0 *clickup.TagsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "SpacesAPI", Method: "Tags"}
+	}
+	return m.TagsFunc(ctx, spaceID, query)
+}
+
+// Views records the call and calls ViewsFunc.
+func (m *SpacesAPI) Views(ctx context.Context, spaceID string, query string) (*clickup.ViewsWrapper, *clickup.Response, error) {
+	m.record("Views", spaceID, query)
+	if m.ViewsFunc == nil {
+		var r0 *clickup.ViewsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "SpacesAPI", Method: "Views"}
+	}
+	return m.ViewsFunc(ctx, spaceID, query)
+}
+
+// CreateTag records the call and calls CreateTagFunc.
+func (m *SpacesAPI) CreateTag(ctx context.Context, spaceID string, tag clickup.Tag) (*clickup.Response, error) {
+	m.record("CreateTag", spaceID, tag)
+	if m.CreateTagFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "SpacesAPI", Method: "CreateTag"}
+	}
+	return m.CreateTagFunc(ctx, spaceID, tag)
+}
+
+// EditTag records the call and calls EditTagFunc.
+func (m *SpacesAPI) EditTag(ctx context.Context, spaceID string, tagName string, tag clickup.Tag) (*clickup.Response, error) {
+	m.record("EditTag", spaceID, tagName, tag)
+	if m.EditTagFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "SpacesAPI", Method: "EditTag"}
+	}
+	return m.EditTagFunc(ctx, spaceID, tagName, tag)
+}
+
+// DeleteTag records the call and calls DeleteTagFunc.
+func (m *SpacesAPI) DeleteTag(ctx context.Context, spaceID string, tagName string) (*clickup.Response, error) {
+	m.record("DeleteTag", spaceID, tagName)
+	if m.DeleteTagFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "SpacesAPI", Method: "DeleteTag"}
+	}
+	return m.DeleteTagFunc(ctx, spaceID, tagName)
+}
+
+// SyncTags records the call and calls SyncTagsFunc.
+func (m *SpacesAPI) SyncTags(ctx context.Context, spaceID string, desired []clickup.Tag) (*clickup.TagSyncResult, *clickup.Response, error) {
+	m.record("SyncTags", spaceID, desired)
+	if m.SyncTagsFunc == nil {
+		var r0 *clickup.TagSyncResult
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "SpacesAPI", Method: "SyncTags"}
+	}
+	return m.SyncTagsFunc(ctx, spaceID, desired)
+}
+
+// FoldersAPI is a mock of clickup.FoldersAPI.
+type FoldersAPI struct {
+	recorder
+
+	GetFunc                func(ctx context.Context, folderID string, query string) (*clickup.Folder, *clickup.Response, error)
+	ListFunc               func(ctx context.Context, spaceID string, query string) (*clickup.FoldersWrapper, *clickup.Response, error)
+	ViewsFunc              func(ctx context.Context, folderID string, query string) (*clickup.ViewsWrapper, *clickup.Response, error)
+	CreateFromTemplateFunc func(ctx context.Context, spaceID string, templateID string, name string) (*clickup.Folder, *clickup.Response, error)
+}
+
+var _ clickup.FoldersAPI = (*FoldersAPI)(nil)
+
+// Get records the call and calls GetFunc.
+func (m *FoldersAPI) Get(ctx context.Context, folderID string, query string) (*clickup.Folder, *clickup.Response, error) {
+	m.record("Get", folderID, query)
+	if m.GetFunc == nil {
+		var r0 *clickup.Folder
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "FoldersAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx, folderID, query)
+}
+
+// List records the call and calls ListFunc.
+func (m *FoldersAPI) List(ctx context.Context, spaceID string, query string) (*clickup.FoldersWrapper, *clickup.Response, error) {
+	m.record("List", spaceID, query)
+	if m.ListFunc == nil {
+		var r0 *clickup.FoldersWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "FoldersAPI", Method: "List"}
+	}
+	return m.ListFunc(ctx, spaceID, query)
+}
+
+// Views records the call and calls ViewsFunc.
+func (m *FoldersAPI) Views(ctx context.Context, folderID string, query string) (*clickup.ViewsWrapper, *clickup.Response, error) {
+	m.record("Views", folderID, query)
+	if m.ViewsFunc == nil {
+		var r0 *clickup.ViewsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "FoldersAPI", Method: "Views"}
+	}
+	return m.ViewsFunc(ctx, folderID, query)
+}
+
+// CreateFromTemplate records the call and calls CreateFromTemplateFunc.
+func (m *FoldersAPI) CreateFromTemplate(ctx context.Context, spaceID string, templateID string, name string) (*clickup.Folder, *clickup.Response, error) {
+	m.record("CreateFromTemplate", spaceID, templateID, name)
+	if m.CreateFromTemplateFunc == nil {
+		var r0 *clickup.Folder
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "FoldersAPI", Method: "CreateFromTemplate"}
+	}
+	return m.CreateFromTemplateFunc(ctx, spaceID, templateID, name)
+}
+
+// ListsAPI is a mock of clickup.ListsAPI.
+type ListsAPI struct {
+	recorder
+
+	GetFunc                          func(ctx context.Context, listID string, query string) (*clickup.List, *clickup.Response, error)
+	GetFolderListsFunc               func(ctx context.Context, folderID string, query string) (*clickup.ListsWrapper, *clickup.Response, error)
+	GetFolderlessListsFunc           func(ctx context.Context, spaceID string, query string) (*clickup.ListsWrapper, *clickup.Response, error)
+	MembersFunc                      func(ctx context.Context, listID string, query string) (*clickup.ListMembersWrapper, *clickup.Response, error)
+	CommentsFunc                     func(ctx context.Context, listID string, query string) (*clickup.ListCommentsWrapper, *clickup.Response, error)
+	ViewsFunc                        func(ctx context.Context, listID string, query string) (*clickup.ViewsWrapper, *clickup.Response, error)
+	CustomFieldsFunc                 func(ctx context.Context, listID string, query string) (*clickup.CustomFieldsWrapper, *clickup.Response, error)
+	CreateFromTemplateFunc           func(ctx context.Context, folderID string, templateID string, name string) (*clickup.List, *clickup.Response, error)
+	CreateFolderlessFromTemplateFunc func(ctx context.Context, spaceID string, templateID string, name string) (*clickup.List, *clickup.Response, error)
+}
+
+var _ clickup.ListsAPI = (*ListsAPI)(nil)
+
+// Get records the call and calls GetFunc.
+func (m *ListsAPI) Get(ctx context.Context, listID string, query string) (*clickup.List, *clickup.Response, error) {
+	m.record("Get", listID, query)
+	if m.GetFunc == nil {
+		var r0 *clickup.List
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ListsAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx, listID, query)
+}
+
+// GetFolderLists records the call and calls GetFolderListsFunc.
+func (m *ListsAPI) GetFolderLists(ctx context.Context, folderID string, query string) (*clickup.ListsWrapper, *clickup.Response, error) {
+	m.record("GetFolderLists", folderID, query)
+	if m.GetFolderListsFunc == nil {
+		var r0 *clickup.ListsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ListsAPI", Method: "GetFolderLists"}
+	}
+	return m.GetFolderListsFunc(ctx, folderID, query)
+}
+
+// GetFolderlessLists records the call and calls GetFolderlessListsFunc.
+func (m *ListsAPI) GetFolderlessLists(ctx context.Context, spaceID string, query string) (*clickup.ListsWrapper, *clickup.Response, error) {
+	m.record("GetFolderlessLists", spaceID, query)
+	if m.GetFolderlessListsFunc == nil {
+		var r0 *clickup.ListsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ListsAPI", Method: "GetFolderlessLists"}
+	}
+	return m.GetFolderlessListsFunc(ctx, spaceID, query)
+}
+
+// Members records the call and calls MembersFunc.
+func (m *ListsAPI) Members(ctx context.Context, listID string, query string) (*clickup.ListMembersWrapper, *clickup.Response, error) {
+	m.record("Members", listID, query)
+	if m.MembersFunc == nil {
+		var r0 *clickup.ListMembersWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ListsAPI", Method: "Members"}
+	}
+	return m.MembersFunc(ctx, listID, query)
+}
+
+// Comments records the call and calls CommentsFunc.
+func (m *ListsAPI) Comments(ctx context.Context, listID string, query string) (*clickup.ListCommentsWrapper, *clickup.Response, error) {
+	m.record("Comments", listID, query)
+	if m.CommentsFunc == nil {
+		var r0 *clickup.ListCommentsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ListsAPI", Method: "Comments"}
+	}
+	return m.CommentsFunc(ctx, listID, query)
+}
+
+// Views records the call and calls ViewsFunc.
+func (m *ListsAPI) Views(ctx context.Context, listID string, query string) (*clickup.ViewsWrapper, *clickup.Response, error) {
+	m.record("Views", listID, query)
+	if m.ViewsFunc == nil {
+		var r0 *clickup.ViewsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ListsAPI", Method: "Views"}
+	}
+	return m.ViewsFunc(ctx, listID, query)
+}
+
+// CustomFields records the call and calls CustomFieldsFunc.
+func (m *ListsAPI) CustomFields(ctx context.Context, listID string, query string) (*clickup.CustomFieldsWrapper, *clickup.Response, error) {
+	m.record("CustomFields", listID, query)
+	if m.CustomFieldsFunc == nil {
+		var r0 *clickup.CustomFieldsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ListsAPI", Method: "CustomFields"}
+	}
+	return m.CustomFieldsFunc(ctx, listID, query)
+}
+
+// CreateFromTemplate records the call and calls CreateFromTemplateFunc.
+func (m *ListsAPI) CreateFromTemplate(ctx context.Context, folderID string, templateID string, name string) (*clickup.List, *clickup.Response, error) {
+	m.record("CreateFromTemplate", folderID, templateID, name)
+	if m.CreateFromTemplateFunc == nil {
+		var r0 *clickup.List
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ListsAPI", Method: "CreateFromTemplate"}
+	}
+	return m.CreateFromTemplateFunc(ctx, folderID, templateID, name)
+}
+
+// CreateFolderlessFromTemplate records the call and calls CreateFolderlessFromTemplateFunc.
+func (m *ListsAPI) CreateFolderlessFromTemplate(ctx context.Context, spaceID string, templateID string, name string) (*clickup.List, *clickup.Response, error) {
+	m.record("CreateFolderlessFromTemplate", spaceID, templateID, name)
+	if m.CreateFolderlessFromTemplateFunc == nil {
+		var r0 *clickup.List
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ListsAPI", Method: "CreateFolderlessFromTemplate"}
+	}
+	return m.CreateFolderlessFromTemplateFunc(ctx, spaceID, templateID, name)
+}
+
+// TasksAPI is a mock of clickup.TasksAPI.
+type TasksAPI struct {
+	recorder
+
+	GetFunc                func(ctx context.Context, taskID string, query string) (*clickup.Task, *clickup.Response, error)
+	ListFunc               func(ctx context.Context, listID string, query string) (*clickup.TasksWrapper, *clickup.Response, error)
+	ForTeamFunc            func(ctx context.Context, teamID string, query string) (*clickup.TasksWrapper, *clickup.Response, error)
+	MembersFunc            func(ctx context.Context, taskID string, query string) (*clickup.TaskMembersWrapper, *clickup.Response, error)
+	CommentsFunc           func(ctx context.Context, taskID string, query string) (*clickup.TaskCommentsWrapper, *clickup.Response, error)
+	AddTagFunc             func(ctx context.Context, taskID string, tagName string, query string) (*clickup.Response, error)
+	RemoveTagFunc          func(ctx context.Context, taskID string, tagName string, query string) (*clickup.Response, error)
+	AddDependencyFunc      func(ctx context.Context, taskID string, opts *clickup.TaskDependencyOptions) (*clickup.Response, error)
+	DeleteDependencyFunc   func(ctx context.Context, taskID string, opts *clickup.TaskDependencyOptions) (*clickup.Response, error)
+	AddTaskLinkFunc        func(ctx context.Context, taskID string, linksTo string) (*clickup.Task, *clickup.Response, error)
+	DeleteTaskLinkFunc     func(ctx context.Context, taskID string, linksTo string) (*clickup.Task, *clickup.Response, error)
+	TrackedTimeFunc        func(ctx context.Context, taskID string, query string) (*clickup.TrackedTimeWrapper, *clickup.Response, error)
+	TrackTimeFunc          func(ctx context.Context, taskID string, interval *clickup.TrackTimeRequest, query string) (*clickup.TimeInterval, *clickup.Response, error)
+	EditTrackedTimeFunc    func(ctx context.Context, taskID string, intervalID string, interval *clickup.TrackTimeRequest, query string) (*clickup.Response, error)
+	DeleteTrackedTimeFunc  func(ctx context.Context, taskID string, intervalID string, query string) (*clickup.Response, error)
+	TimeInStatusFunc       func(ctx context.Context, taskID string, opts *clickup.TimeInStatusOptions) (*clickup.TaskTimeInStatus, *clickup.Response, error)
+	BulkTimeInStatusFunc   func(ctx context.Context, taskIDs []string, opts *clickup.TimeInStatusOptions) (map[string]clickup.TaskTimeInStatus, *clickup.Response, error)
+	CreateFromTemplateFunc func(ctx context.Context, listID string, templateID string, name string) (*clickup.Task, *clickup.Response, error)
+}
+
+var _ clickup.TasksAPI = (*TasksAPI)(nil)
+
+// Get records the call and calls GetFunc.
+func (m *TasksAPI) Get(ctx context.Context, taskID string, query string) (*clickup.Task, *clickup.Response, error) {
+	m.record("Get", taskID, query)
+	if m.GetFunc == nil {
+		var r0 *clickup.Task
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx, taskID, query)
+}
+
+// List records the call and calls ListFunc.
+func (m *TasksAPI) List(ctx context.Context, listID string, query string) (*clickup.TasksWrapper, *clickup.Response, error) {
+	m.record("List", listID, query)
+	if m.ListFunc == nil {
+		var r0 *clickup.TasksWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "List"}
+	}
+	return m.ListFunc(ctx, listID, query)
+}
+
+// ForTeam records the call and calls ForTeamFunc.
+func (m *TasksAPI) ForTeam(ctx context.Context, teamID string, query string) (*clickup.TasksWrapper, *clickup.Response, error) {
+	m.record("ForTeam", teamID, query)
+	if m.ForTeamFunc == nil {
+		var r0 *clickup.TasksWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "ForTeam"}
+	}
+	return m.ForTeamFunc(ctx, teamID, query)
+}
+
+// Members records the call and calls MembersFunc.
+func (m *TasksAPI) Members(ctx context.Context, taskID string, query string) (*clickup.TaskMembersWrapper, *clickup.Response, error) {
+	m.record("Members", taskID, query)
+	if m.MembersFunc == nil {
+		var r0 *clickup.TaskMembersWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "Members"}
+	}
+	return m.MembersFunc(ctx, taskID, query)
+}
+
+// Comments records the call and calls CommentsFunc.
+func (m *TasksAPI) Comments(ctx context.Context, taskID string, query string) (*clickup.TaskCommentsWrapper, *clickup.Response, error) {
+	m.record("Comments", taskID, query)
+	if m.CommentsFunc == nil {
+		var r0 *clickup.TaskCommentsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "Comments"}
+	}
+	return m.CommentsFunc(ctx, taskID, query)
+}
+
+// AddTag records the call and calls AddTagFunc.
+func (m *TasksAPI) AddTag(ctx context.Context, taskID string, tagName string, query string) (*clickup.Response, error) {
+	m.record("AddTag", taskID, tagName, query)
+	if m.AddTagFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TasksAPI", Method: "AddTag"}
+	}
+	return m.AddTagFunc(ctx, taskID, tagName, query)
+}
+
+// RemoveTag records the call and calls RemoveTagFunc.
+func (m *TasksAPI) RemoveTag(ctx context.Context, taskID string, tagName string, query string) (*clickup.Response, error) {
+	m.record("RemoveTag", taskID, tagName, query)
+	if m.RemoveTagFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TasksAPI", Method: "RemoveTag"}
+	}
+	return m.RemoveTagFunc(ctx, taskID, tagName, query)
+}
+
+// AddDependency records the call and calls AddDependencyFunc.
+func (m *TasksAPI) AddDependency(ctx context.Context, taskID string, opts *clickup.TaskDependencyOptions) (*clickup.Response, error) {
+	m.record("AddDependency", taskID, opts)
+	if m.AddDependencyFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TasksAPI", Method: "AddDependency"}
+	}
+	return m.AddDependencyFunc(ctx, taskID, opts)
+}
+
+// DeleteDependency records the call and calls DeleteDependencyFunc.
+func (m *TasksAPI) DeleteDependency(ctx context.Context, taskID string, opts *clickup.TaskDependencyOptions) (*clickup.Response, error) {
+	m.record("DeleteDependency", taskID, opts)
+	if m.DeleteDependencyFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TasksAPI", Method: "DeleteDependency"}
+	}
+	return m.DeleteDependencyFunc(ctx, taskID, opts)
+}
+
+// AddTaskLink records the call and calls AddTaskLinkFunc.
+func (m *TasksAPI) AddTaskLink(ctx context.Context, taskID string, linksTo string) (*clickup.Task, *clickup.Response, error) {
+	m.record("AddTaskLink", taskID, linksTo)
+	if m.AddTaskLinkFunc == nil {
+		var r0 *clickup.Task
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "AddTaskLink"}
+	}
+	return m.AddTaskLinkFunc(ctx, taskID, linksTo)
+}
+
+// DeleteTaskLink records the call and calls DeleteTaskLinkFunc.
+func (m *TasksAPI) DeleteTaskLink(ctx context.Context, taskID string, linksTo string) (*clickup.Task, *clickup.Response, error) {
+	m.record("DeleteTaskLink", taskID, linksTo)
+	if m.DeleteTaskLinkFunc == nil {
+		var r0 *clickup.Task
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "DeleteTaskLink"}
+	}
+	return m.DeleteTaskLinkFunc(ctx, taskID, linksTo)
+}
+
+// TrackedTime records the call and calls TrackedTimeFunc.
+func (m *TasksAPI) TrackedTime(ctx context.Context, taskID string, query string) (*clickup.TrackedTimeWrapper, *clickup.Response, error) {
+	m.record("TrackedTime", taskID, query)
+	if m.TrackedTimeFunc == nil {
+		var r0 *clickup.TrackedTimeWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "TrackedTime"}
+	}
+	return m.TrackedTimeFunc(ctx, taskID, query)
+}
+
+// TrackTime records the call and calls TrackTimeFunc.
+func (m *TasksAPI) TrackTime(ctx context.Context, taskID string, interval *clickup.TrackTimeRequest, query string) (*clickup.TimeInterval, *clickup.Response, error) {
+	m.record("TrackTime", taskID, interval, query)
+	if m.TrackTimeFunc == nil {
+		var r0 *clickup.TimeInterval
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "TrackTime"}
+	}
+	return m.TrackTimeFunc(ctx, taskID, interval, query)
+}
+
+// EditTrackedTime records the call and calls EditTrackedTimeFunc.
+func (m *TasksAPI) EditTrackedTime(ctx context.Context, taskID string, intervalID string, interval *clickup.TrackTimeRequest, query string) (*clickup.Response, error) {
+	m.record("EditTrackedTime", taskID, intervalID, interval, query)
+	if m.EditTrackedTimeFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TasksAPI", Method: "EditTrackedTime"}
+	}
+	return m.EditTrackedTimeFunc(ctx, taskID, intervalID, interval, query)
+}
+
+// DeleteTrackedTime records the call and calls DeleteTrackedTimeFunc.
+func (m *TasksAPI) DeleteTrackedTime(ctx context.Context, taskID string, intervalID string, query string) (*clickup.Response, error) {
+	m.record("DeleteTrackedTime", taskID, intervalID, query)
+	if m.DeleteTrackedTimeFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TasksAPI", Method: "DeleteTrackedTime"}
+	}
+	return m.DeleteTrackedTimeFunc(ctx, taskID, intervalID, query)
+}
+
+// TimeInStatus records the call and calls TimeInStatusFunc.
+func (m *TasksAPI) TimeInStatus(ctx context.Context, taskID string, opts *clickup.TimeInStatusOptions) (*clickup.TaskTimeInStatus, *clickup.Response, error) {
+	m.record("TimeInStatus", taskID, opts)
+	if m.TimeInStatusFunc == nil {
+		var r0 *clickup.TaskTimeInStatus
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "TimeInStatus"}
+	}
+	return m.TimeInStatusFunc(ctx, taskID, opts)
+}
+
+// BulkTimeInStatus records the call and calls BulkTimeInStatusFunc.
+func (m *TasksAPI) BulkTimeInStatus(ctx context.Context, taskIDs []string, opts *clickup.TimeInStatusOptions) (map[string]clickup.TaskTimeInStatus, *clickup.Response, error) {
+	m.record("BulkTimeInStatus", taskIDs, opts)
+	if m.BulkTimeInStatusFunc == nil {
+		var r0 map[string]clickup.TaskTimeInStatus
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "BulkTimeInStatus"}
+	}
+	return m.BulkTimeInStatusFunc(ctx, taskIDs, opts)
+}
+
+// CreateFromTemplate records the call and calls CreateFromTemplateFunc.
+func (m *TasksAPI) CreateFromTemplate(ctx context.Context, listID string, templateID string, name string) (*clickup.Task, *clickup.Response, error) {
+	m.record("CreateFromTemplate", listID, templateID, name)
+	if m.CreateFromTemplateFunc == nil {
+		var r0 *clickup.Task
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TasksAPI", Method: "CreateFromTemplate"}
+	}
+	return m.CreateFromTemplateFunc(ctx, listID, templateID, name)
+}
+
+// GroupsAPI is a mock of clickup.GroupsAPI.
+type GroupsAPI struct {
+	recorder
+
+	GetFunc        func(ctx context.Context, query string) (*clickup.GroupsWrapper, *clickup.Response, error)
+	ListFunc       func(ctx context.Context, teamID string, groupIDs ...string) (*clickup.GroupsWrapper, *clickup.Response, error)
+	CreateFunc     func(ctx context.Context, teamID string, name string, handle string, members []int64) (*clickup.Group, *clickup.Response, error)
+	UpdateFunc     func(ctx context.Context, groupID string, update *clickup.GroupUpdate) (*clickup.Group, *clickup.Response, error)
+	DeleteFunc     func(ctx context.Context, groupID string) (*clickup.Response, error)
+	SyncGroupsFunc func(ctx context.Context, teamID string, desired map[string][]int64, prune bool) (*clickup.GroupSyncResult, *clickup.Response, error)
+}
+
+var _ clickup.GroupsAPI = (*GroupsAPI)(nil)
+
+// Get records the call and calls GetFunc.
+func (m *GroupsAPI) Get(ctx context.Context, query string) (*clickup.GroupsWrapper, *clickup.Response, error) {
+	m.record("Get", query)
+	if m.GetFunc == nil {
+		var r0 *clickup.GroupsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GroupsAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx, query)
+}
+
+// List records the call and calls ListFunc.
+func (m *GroupsAPI) List(ctx context.Context, teamID string, groupIDs ...string) (*clickup.GroupsWrapper, *clickup.Response, error) {
+	m.record("List", teamID, groupIDs)
+	if m.ListFunc == nil {
+		var r0 *clickup.GroupsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GroupsAPI", Method: "List"}
+	}
+	return m.ListFunc(ctx, teamID, groupIDs...)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *GroupsAPI) Create(ctx context.Context, teamID string, name string, handle string, members []int64) (*clickup.Group, *clickup.Response, error) {
+	m.record("Create", teamID, name, handle, members)
+	if m.CreateFunc == nil {
+		var r0 *clickup.Group
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GroupsAPI", Method: "Create"}
+	}
+	return m.CreateFunc(ctx, teamID, name, handle, members)
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *GroupsAPI) Update(ctx context.Context, groupID string, update *clickup.GroupUpdate) (*clickup.Group, *clickup.Response, error) {
+	m.record("Update", groupID, update)
+	if m.UpdateFunc == nil {
+		var r0 *clickup.Group
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GroupsAPI", Method: "Update"}
+	}
+	return m.UpdateFunc(ctx, groupID, update)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *GroupsAPI) Delete(ctx context.Context, groupID string) (*clickup.Response, error) {
+	m.record("Delete", groupID)
+	if m.DeleteFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "GroupsAPI", Method: "Delete"}
+	}
+	return m.DeleteFunc(ctx, groupID)
+}
+
+// SyncGroups records the call and calls SyncGroupsFunc.
+func (m *GroupsAPI) SyncGroups(ctx context.Context, teamID string, desired map[string][]int64, prune bool) (*clickup.GroupSyncResult, *clickup.Response, error) {
+	m.record("SyncGroups", teamID, desired, prune)
+	if m.SyncGroupsFunc == nil {
+		var r0 *clickup.GroupSyncResult
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GroupsAPI", Method: "SyncGroups"}
+	}
+	return m.SyncGroupsFunc(ctx, teamID, desired, prune)
+}
+
+// GoalsAPI is a mock of clickup.GoalsAPI.
+type GoalsAPI struct {
+	recorder
+
+	ListFunc func(ctx context.Context, workspaceID string, query string) (*clickup.GoalsWrapper, *clickup.Response, error)
+	GetFunc  func(ctx context.Context, goalID string, query string) (*clickup.GoalWrapper, *clickup.Response, error)
+}
+
+var _ clickup.GoalsAPI = (*GoalsAPI)(nil)
+
+// List records the call and calls ListFunc.
+func (m *GoalsAPI) List(ctx context.Context, workspaceID string, query string) (*clickup.GoalsWrapper, *clickup.Response, error) {
+	m.record("List", workspaceID, query)
+	if m.ListFunc == nil {
+		var r0 *clickup.GoalsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GoalsAPI", Method: "List"}
+	}
+	return m.ListFunc(ctx, workspaceID, query)
+}
+
+// Get records the call and calls GetFunc.
+func (m *GoalsAPI) Get(ctx context.Context, goalID string, query string) (*clickup.GoalWrapper, *clickup.Response, error) {
+	m.record("Get", goalID, query)
+	if m.GetFunc == nil {
+		var r0 *clickup.GoalWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GoalsAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx, goalID, query)
+}
+
+// TimeTrackingAPI is a mock of clickup.TimeTrackingAPI.
+type TimeTrackingAPI struct {
+	recorder
+
+	ListEntriesFunc func(ctx context.Context, teamID string, opts *clickup.TimeEntryOptions) (*clickup.TimeEntriesWrapper, *clickup.Response, error)
+	GetFunc         func(ctx context.Context, teamID string, timerID string, query string) (*clickup.TimeEntry, *clickup.Response, error)
+	HistoryFunc     func(ctx context.Context, teamID string, timerID string) (*clickup.TimeEntryHistoryWrapper, *clickup.Response, error)
+	RunningFunc     func(ctx context.Context, teamID string, assignee int64) (*clickup.TimeEntry, *clickup.Response, error)
+	StartFunc       func(ctx context.Context, teamID string, entry *clickup.TimeEntryRequest) (*clickup.TimeEntry, *clickup.Response, error)
+	StopFunc        func(ctx context.Context, teamID string) (*clickup.TimeEntry, *clickup.Response, error)
+	CreateFunc      func(ctx context.Context, teamID string, entry *clickup.TimeEntryRequest) (*clickup.TimeEntry, *clickup.Response, error)
+	UpdateFunc      func(ctx context.Context, teamID string, timerID string, entry *clickup.TimeEntryRequest) (*clickup.Response, error)
+	DeleteFunc      func(ctx context.Context, teamID string, timerID string) (*clickup.Response, error)
+	TagsFunc        func(ctx context.Context, teamID string) (*clickup.TimeEntryTagsWrapper, *clickup.Response, error)
+	AddTagsFunc     func(ctx context.Context, teamID string, timerIDs []string, tags []clickup.Tag) (*clickup.Response, error)
+	RemoveTagsFunc  func(ctx context.Context, teamID string, timerIDs []string, tags []clickup.Tag) (*clickup.Response, error)
+	EditTagFunc     func(ctx context.Context, teamID string, tagName string, tag clickup.Tag) (*clickup.Response, error)
+}
+
+var _ clickup.TimeTrackingAPI = (*TimeTrackingAPI)(nil)
+
+// ListEntries records the call and calls ListEntriesFunc.
+func (m *TimeTrackingAPI) ListEntries(ctx context.Context, teamID string, opts *clickup.TimeEntryOptions) (*clickup.TimeEntriesWrapper, *clickup.Response, error) {
+	m.record("ListEntries", teamID, opts)
+	if m.ListEntriesFunc == nil {
+		var r0 *clickup.TimeEntriesWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TimeTrackingAPI", Method: "ListEntries"}
+	}
+	return m.ListEntriesFunc(ctx, teamID, opts)
+}
+
+// Get records the call and calls GetFunc.
+func (m *TimeTrackingAPI) Get(ctx context.Context, teamID string, timerID string, query string) (*clickup.TimeEntry, *clickup.Response, error) {
+	m.record("Get", teamID, timerID, query)
+	if m.GetFunc == nil {
+		var r0 *clickup.TimeEntry
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TimeTrackingAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx, teamID, timerID, query)
+}
+
+// History records the call and calls HistoryFunc.
+func (m *TimeTrackingAPI) History(ctx context.Context, teamID string, timerID string) (*clickup.TimeEntryHistoryWrapper, *clickup.Response, error) {
+	m.record("History", teamID, timerID)
+	if m.HistoryFunc == nil {
+		var r0 *clickup.TimeEntryHistoryWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TimeTrackingAPI", Method: "History"}
+	}
+	return m.HistoryFunc(ctx, teamID, timerID)
+}
+
+// Running records the call and calls RunningFunc.
+func (m *TimeTrackingAPI) Running(ctx context.Context, teamID string, assignee int64) (*clickup.TimeEntry, *clickup.Response, error) {
+	m.record("Running", teamID, assignee)
+	if m.RunningFunc == nil {
+		var r0 *clickup.TimeEntry
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TimeTrackingAPI", Method: "Running"}
+	}
+	return m.RunningFunc(ctx, teamID, assignee)
+}
+
+// Start records the call and calls StartFunc.
+func (m *TimeTrackingAPI) Start(ctx context.Context, teamID string, entry *clickup.TimeEntryRequest) (*clickup.TimeEntry, *clickup.Response, error) {
+	m.record("Start", teamID, entry)
+	if m.StartFunc == nil {
+		var r0 *clickup.TimeEntry
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TimeTrackingAPI", Method: "Start"}
+	}
+	return m.StartFunc(ctx, teamID, entry)
+}
+
+// Stop records the call and calls StopFunc.
+func (m *TimeTrackingAPI) Stop(ctx context.Context, teamID string) (*clickup.TimeEntry, *clickup.Response, error) {
+	m.record("Stop", teamID)
+	if m.StopFunc == nil {
+		var r0 *clickup.TimeEntry
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TimeTrackingAPI", Method: "Stop"}
+	}
+	return m.StopFunc(ctx, teamID)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *TimeTrackingAPI) Create(ctx context.Context, teamID string, entry *clickup.TimeEntryRequest) (*clickup.TimeEntry, *clickup.Response, error) {
+	m.record("Create", teamID, entry)
+	if m.CreateFunc == nil {
+		var r0 *clickup.TimeEntry
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TimeTrackingAPI", Method: "Create"}
+	}
+	return m.CreateFunc(ctx, teamID, entry)
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *TimeTrackingAPI) Update(ctx context.Context, teamID string, timerID string, entry *clickup.TimeEntryRequest) (*clickup.Response, error) {
+	m.record("Update", teamID, timerID, entry)
+	if m.UpdateFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TimeTrackingAPI", Method: "Update"}
+	}
+	return m.UpdateFunc(ctx, teamID, timerID, entry)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *TimeTrackingAPI) Delete(ctx context.Context, teamID string, timerID string) (*clickup.Response, error) {
+	m.record("Delete", teamID, timerID)
+	if m.DeleteFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TimeTrackingAPI", Method: "Delete"}
+	}
+	return m.DeleteFunc(ctx, teamID, timerID)
+}
+
+// Tags records the call and calls TagsFunc.
+func (m *TimeTrackingAPI) Tags(ctx context.Context, teamID string) (*clickup.TimeEntryTagsWrapper, *clickup.Response, error) {
+	m.record("Tags", teamID)
+	if m.TagsFunc == nil {
+		var r0 *clickup.TimeEntryTagsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "TimeTrackingAPI", Method: "Tags"}
+	}
+	return m.TagsFunc(ctx, teamID)
+}
+
+// AddTags records the call and calls AddTagsFunc.
+func (m *TimeTrackingAPI) AddTags(ctx context.Context, teamID string, timerIDs []string, tags []clickup.Tag) (*clickup.Response, error) {
+	m.record("AddTags", teamID, timerIDs, tags)
+	if m.AddTagsFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TimeTrackingAPI", Method: "AddTags"}
+	}
+	return m.AddTagsFunc(ctx, teamID, timerIDs, tags)
+}
+
+// RemoveTags records the call and calls RemoveTagsFunc.
+func (m *TimeTrackingAPI) RemoveTags(ctx context.Context, teamID string, timerIDs []string, tags []clickup.Tag) (*clickup.Response, error) {
+	m.record("RemoveTags", teamID, timerIDs, tags)
+	if m.RemoveTagsFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TimeTrackingAPI", Method: "RemoveTags"}
+	}
+	return m.RemoveTagsFunc(ctx, teamID, timerIDs, tags)
+}
+
+// EditTag records the call and calls EditTagFunc.
+func (m *TimeTrackingAPI) EditTag(ctx context.Context, teamID string, tagName string, tag clickup.Tag) (*clickup.Response, error) {
+	m.record("EditTag", teamID, tagName, tag)
+	if m.EditTagFunc == nil {
+		var r0 *clickup.Response
+		return r0, &NotStubbedError{API: "TimeTrackingAPI", Method: "EditTag"}
+	}
+	return m.EditTagFunc(ctx, teamID, tagName, tag)
+}
+
+// UsersAPI is a mock of clickup.UsersAPI.
+type UsersAPI struct {
+	recorder
+
+	InviteFunc func(ctx context.Context, teamID string, email string, admin bool, customRoleID int64) (*clickup.Workspace, *clickup.Response, error)
+	GetFunc    func(ctx context.Context, teamID string, userID int64) (*clickup.Member, *clickup.Response, error)
+	EditFunc   func(ctx context.Context, teamID string, userID int64, user *clickup.EditUserRequest) (*clickup.Member, *clickup.Response, error)
+	RemoveFunc func(ctx context.Context, teamID string, userID int64) (*clickup.Workspace, *clickup.Response, error)
+}
+
+var _ clickup.UsersAPI = (*UsersAPI)(nil)
+
+// Invite records the call and calls InviteFunc.
+func (m *UsersAPI) Invite(ctx context.Context, teamID string, email string, admin bool, customRoleID int64) (*clickup.Workspace, *clickup.Response, error) {
+	m.record("Invite", teamID, email, admin, customRoleID)
+	if m.InviteFunc == nil {
+		var r0 *clickup.Workspace
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "UsersAPI", Method: "Invite"}
+	}
+	return m.InviteFunc(ctx, teamID, email, admin, customRoleID)
+}
+
+// Get records the call and calls GetFunc.
+func (m *UsersAPI) Get(ctx context.Context, teamID string, userID int64) (*clickup.Member, *clickup.Response, error) {
+	m.record("Get", teamID, userID)
+	if m.GetFunc == nil {
+		var r0 *clickup.Member
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "UsersAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx, teamID, userID)
+}
+
+// Edit records the call and calls EditFunc.
+func (m *UsersAPI) Edit(ctx context.Context, teamID string, userID int64, user *clickup.EditUserRequest) (*clickup.Member, *clickup.Response, error) {
+	m.record("Edit", teamID, userID, user)
+	if m.EditFunc == nil {
+		var r0 *clickup.Member
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "UsersAPI", Method: "Edit"}
+	}
+	return m.EditFunc(ctx, teamID, userID, user)
+}
+
+// Remove records the call and calls RemoveFunc.
+func (m *UsersAPI) Remove(ctx context.Context, teamID string, userID int64) (*clickup.Workspace, *clickup.Response, error) {
+	m.record("Remove", teamID, userID)
+	if m.RemoveFunc == nil {
+		var r0 *clickup.Workspace
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "UsersAPI", Method: "Remove"}
+	}
+	return m.RemoveFunc(ctx, teamID, userID)
+}
+
+// GuestsAPI is a mock of clickup.GuestsAPI.
+type GuestsAPI struct {
+	recorder
+
+	InviteFunc           func(ctx context.Context, teamID string, guest *clickup.GuestRequest) (*clickup.Workspace, *clickup.Response, error)
+	GetFunc              func(ctx context.Context, teamID string, guestID int64) (*clickup.Guest, *clickup.Response, error)
+	EditFunc             func(ctx context.Context, teamID string, guestID int64, guest *clickup.GuestRequest) (*clickup.Guest, *clickup.Response, error)
+	RemoveFunc           func(ctx context.Context, teamID string, guestID int64) (*clickup.Workspace, *clickup.Response, error)
+	AddToTaskFunc        func(ctx context.Context, taskID string, guestID int64, level clickup.Permission, query string) (*clickup.Guest, *clickup.Response, error)
+	RemoveFromTaskFunc   func(ctx context.Context, taskID string, guestID int64, query string) (*clickup.Guest, *clickup.Response, error)
+	AddToListFunc        func(ctx context.Context, listID string, guestID int64, level clickup.Permission, query string) (*clickup.Guest, *clickup.Response, error)
+	RemoveFromListFunc   func(ctx context.Context, listID string, guestID int64, query string) (*clickup.Guest, *clickup.Response, error)
+	AddToFolderFunc      func(ctx context.Context, folderID string, guestID int64, level clickup.Permission, query string) (*clickup.Guest, *clickup.Response, error)
+	RemoveFromFolderFunc func(ctx context.Context, folderID string, guestID int64, query string) (*clickup.Guest, *clickup.Response, error)
+}
+
+var _ clickup.GuestsAPI = (*GuestsAPI)(nil)
+
+// Invite records the call and calls InviteFunc.
+func (m *GuestsAPI) Invite(ctx context.Context, teamID string, guest *clickup.GuestRequest) (*clickup.Workspace, *clickup.Response, error) {
+	m.record("Invite", teamID, guest)
+	if m.InviteFunc == nil {
+		var r0 *clickup.Workspace
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GuestsAPI", Method: "Invite"}
+	}
+	return m.InviteFunc(ctx, teamID, guest)
+}
+
+// Get records the call and calls GetFunc.
+func (m *GuestsAPI) Get(ctx context.Context, teamID string, guestID int64) (*clickup.Guest, *clickup.Response, error) {
+	m.record("Get", teamID, guestID)
+	if m.GetFunc == nil {
+		var r0 *clickup.Guest
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GuestsAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx, teamID, guestID)
+}
+
+// Edit records the call and calls EditFunc.
+func (m *GuestsAPI) Edit(ctx context.Context, teamID string, guestID int64, guest *clickup.GuestRequest) (*clickup.Guest, *clickup.Response, error) {
+	m.record("Edit", teamID, guestID, guest)
+	if m.EditFunc == nil {
+		var r0 *clickup.Guest
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GuestsAPI", Method: "Edit"}
+	}
+	return m.EditFunc(ctx, teamID, guestID, guest)
+}
+
+// Remove records the call and calls RemoveFunc.
+func (m *GuestsAPI) Remove(ctx context.Context, teamID string, guestID int64) (*clickup.Workspace, *clickup.Response, error) {
+	m.record("Remove", teamID, guestID)
+	if m.RemoveFunc == nil {
+		var r0 *clickup.Workspace
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GuestsAPI", Method: "Remove"}
+	}
+	return m.RemoveFunc(ctx, teamID, guestID)
+}
+
+// AddToTask records the call and calls AddToTaskFunc.
+func (m *GuestsAPI) AddToTask(ctx context.Context, taskID string, guestID int64, level clickup.Permission, query string) (*clickup.Guest, *clickup.Response, error) {
+	m.record("AddToTask", taskID, guestID, level, query)
+	if m.AddToTaskFunc == nil {
+		var r0 *clickup.Guest
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GuestsAPI", Method: "AddToTask"}
+	}
+	return m.AddToTaskFunc(ctx, taskID, guestID, level, query)
+}
+
+// RemoveFromTask records the call and calls RemoveFromTaskFunc.
+func (m *GuestsAPI) RemoveFromTask(ctx context.Context, taskID string, guestID int64, query string) (*clickup.Guest, *clickup.Response, error) {
+	m.record("RemoveFromTask", taskID, guestID, query)
+	if m.RemoveFromTaskFunc == nil {
+		var r0 *clickup.Guest
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GuestsAPI", Method: "RemoveFromTask"}
+	}
+	return m.RemoveFromTaskFunc(ctx, taskID, guestID, query)
+}
+
+// AddToList records the call and calls AddToListFunc.
+func (m *GuestsAPI) AddToList(ctx context.Context, listID string, guestID int64, level clickup.Permission, query string) (*clickup.Guest, *clickup.Response, error) {
+	m.record("AddToList", listID, guestID, level, query)
+	if m.AddToListFunc == nil {
+		var r0 *clickup.Guest
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GuestsAPI", Method: "AddToList"}
+	}
+	return m.AddToListFunc(ctx, listID, guestID, level, query)
+}
+
+// RemoveFromList records the call and calls RemoveFromListFunc.
+func (m *GuestsAPI) RemoveFromList(ctx context.Context, listID string, guestID int64, query string) (*clickup.Guest, *clickup.Response, error) {
+	m.record("RemoveFromList", listID, guestID, query)
+	if m.RemoveFromListFunc == nil {
+		var r0 *clickup.Guest
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GuestsAPI", Method: "RemoveFromList"}
+	}
+	return m.RemoveFromListFunc(ctx, listID, guestID, query)
+}
+
+// AddToFolder records the call and calls AddToFolderFunc.
+func (m *GuestsAPI) AddToFolder(ctx context.Context, folderID string, guestID int64, level clickup.Permission, query string) (*clickup.Guest, *clickup.Response, error) {
+	m.record("AddToFolder", folderID, guestID, level, query)
+	if m.AddToFolderFunc == nil {
+		var r0 *clickup.Guest
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GuestsAPI", Method: "AddToFolder"}
+	}
+	return m.AddToFolderFunc(ctx, folderID, guestID, level, query)
+}
+
+// RemoveFromFolder records the call and calls RemoveFromFolderFunc.
+func (m *GuestsAPI) RemoveFromFolder(ctx context.Context, folderID string, guestID int64, query string) (*clickup.Guest, *clickup.Response, error) {
+	m.record("RemoveFromFolder", folderID, guestID, query)
+	if m.RemoveFromFolderFunc == nil {
+		var r0 *clickup.Guest
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "GuestsAPI", Method: "RemoveFromFolder"}
+	}
+	return m.RemoveFromFolderFunc(ctx, folderID, guestID, query)
+}
+
+// ViewsAPI is a mock of clickup.ViewsAPI.
+type ViewsAPI struct {
+	recorder
+
+	GetFunc      func(ctx context.Context, viewID string, query string) (*clickup.ViewWrapper, *clickup.Response, error)
+	TasksFunc    func(ctx context.Context, viewID string, query string) (*clickup.TasksWrapper, *clickup.Response, error)
+	CommentsFunc func(ctx context.Context, viewID string, query string) (*clickup.ChatViewCommentsWrapper, *clickup.Response, error)
+}
+
+var _ clickup.ViewsAPI = (*ViewsAPI)(nil)
+
+// Get records the call and calls GetFunc.
+func (m *ViewsAPI) Get(ctx context.Context, viewID string, query string) (*clickup.ViewWrapper, *clickup.Response, error) {
+	m.record("Get", viewID, query)
+	if m.GetFunc == nil {
+		var r0 *clickup.ViewWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ViewsAPI", Method: "Get"}
+	}
+	return m.GetFunc(ctx, viewID, query)
+}
+
+// Tasks records the call and calls TasksFunc.
+func (m *ViewsAPI) Tasks(ctx context.Context, viewID string, query string) (*clickup.TasksWrapper, *clickup.Response, error) {
+	m.record("Tasks", viewID, query)
+	if m.TasksFunc == nil {
+		var r0 *clickup.TasksWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ViewsAPI", Method: "Tasks"}
+	}
+	return m.TasksFunc(ctx, viewID, query)
+}
+
+// Comments records the call and calls CommentsFunc.
+func (m *ViewsAPI) Comments(ctx context.Context, viewID string, query string) (*clickup.ChatViewCommentsWrapper, *clickup.Response, error) {
+	m.record("Comments", viewID, query)
+	if m.CommentsFunc == nil {
+		var r0 *clickup.ChatViewCommentsWrapper
+		var r1 *clickup.Response
+		return r0, r1, &NotStubbedError{API: "ViewsAPI", Method: "Comments"}
+	}
+	return m.CommentsFunc(ctx, viewID, query)
+}
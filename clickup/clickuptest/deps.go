@@ -0,0 +1,188 @@
+package clickuptest
+
+import "strconv"
+
+// dependencyRequest is the body of Add Dependency and the query of Delete
+// Dependency. Exactly one of the fields must be set.
+type dependencyRequest struct {
+	DependsOn    string `json:"depends_on"`
+	DependencyOf string `json:"dependency_of"`
+}
+
+// resolveDependency returns the waiting task and the task it waits on for a request
+// made on the task t.
+func (s *Server) resolveDependency(t *task, req dependencyRequest) (string, string, *Error) {
+	if (req.DependsOn == "") == (req.DependencyOf == "") {
+		return "", "", badRequest("Either depends_on or dependency_of is required")
+	}
+	other := req.DependsOn
+	if other == "" {
+		other = req.DependencyOf
+	}
+	if _, err := s.task(other); err != nil {
+		return "", "", err
+	}
+	if other == t.id {
+		return "", "", badRequest("Task cannot depend on itself")
+	}
+	if req.DependsOn != "" {
+		return t.id, other, nil
+	}
+	return other, t.id, nil
+}
+
+func (s *Server) findDependency(taskID, dependsOn string) *dependency {
+	for _, d := range s.dependencies {
+		if d.taskID == taskID && d.dependsOn == dependsOn {
+			return d
+		}
+	}
+	return nil
+}
+
+func (s *Server) addDependency(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req dependencyRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	waiting, on, err := s.resolveDependency(t, req)
+	if err != nil {
+		return nil, err
+	}
+	if s.findDependency(waiting, on) == nil {
+		s.dependencies = append(s.dependencies, &dependency{taskID: waiting, dependsOn: on, user: s.User.ID, created: s.Now()})
+	}
+	return object{}, nil
+}
+
+func (s *Server) deleteDependency(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	q := c.r.URL.Query()
+	waiting, on, err := s.resolveDependency(t, dependencyRequest{q.Get("depends_on"), q.Get("dependency_of")})
+	if err != nil {
+		return nil, err
+	}
+	d := s.findDependency(waiting, on)
+	if d == nil {
+		return nil, notFound("Dependency")
+	}
+	s.removeDependencies(func(v *dependency) bool { return v == d })
+	return object{}, nil
+}
+
+// removeDependencies removes the dependencies matching drop.
+func (s *Server) removeDependencies(drop func(*dependency) bool) {
+	kept := s.dependencies[:0]
+	for _, d := range s.dependencies {
+		if !drop(d) {
+			kept = append(kept, d)
+		}
+	}
+	s.dependencies = kept
+}
+
+// addTaskLink links two tasks. Links have no direction, so linking them
+// again either way changes nothing.
+func (s *Server) addTaskLink(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	other, err := s.task(c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	if other == t {
+		return nil, badRequest("Task cannot be linked to itself")
+	}
+	if s.findLink(t.id, other.id) == nil {
+		s.links = append(s.links, &link{taskID: t.id, linkID: other.id, user: s.User.ID, created: s.Now()})
+	}
+	return object{"task": s.renderTask(t)}, nil
+}
+
+func (s *Server) deleteTaskLink(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	l := s.findLink(t.id, c.ids[1])
+	if l == nil {
+		return nil, notFound("Link")
+	}
+	s.removeLinks(func(v *link) bool { return v == l })
+	return object{"task": s.renderTask(t)}, nil
+}
+
+func (s *Server) findLink(a, b string) *link {
+	for _, l := range s.links {
+		if l.taskID == a && l.linkID == b || l.taskID == b && l.linkID == a {
+			return l
+		}
+	}
+	return nil
+}
+
+// removeLinks removes the links matching drop.
+func (s *Server) removeLinks(drop func(*link) bool) {
+	kept := s.links[:0]
+	for _, l := range s.links {
+		if !drop(l) {
+			kept = append(kept, l)
+		}
+	}
+	s.links = kept
+}
+
+// renderDependencies renders the dependencies t is part of, on either side.
+func (s *Server) renderDependencies(t *task) []object {
+	out := []object{}
+	for _, d := range s.dependencies {
+		if d.taskID != t.id && d.dependsOn != t.id {
+			continue
+		}
+		// Type 1 is a task t waits on, 0 a task waiting on t.
+		typ := 0
+		if d.taskID == t.id {
+			typ = 1
+		}
+		out = append(out, object{
+			"task_id":      d.taskID,
+			"depends_on":   d.dependsOn,
+			"type":         typ,
+			"date_created": millis(d.created),
+			"userid":       strconv.FormatInt(d.user, 10),
+		})
+	}
+	return out
+}
+
+// renderLinks renders the links of t, with t as task_id.
+func (s *Server) renderLinks(t *task) []object {
+	out := []object{}
+	for _, l := range s.links {
+		other := ""
+		switch t.id {
+		case l.taskID:
+			other = l.linkID
+		case l.linkID:
+			other = l.taskID
+		default:
+			continue
+		}
+		out = append(out, object{
+			"task_id":      t.id,
+			"link_id":      other,
+			"date_created": millis(l.created),
+			"userid":       strconv.FormatInt(l.user, 10),
+		})
+	}
+	return out
+}
@@ -0,0 +1,73 @@
+package clickuptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// fixtureMeta is the content of a .meta.json file.
+type fixtureMeta struct {
+	Status      int             `json:"status"`
+	Header      http.Header     `json:"header,omitempty"`
+	RequestBody json.RawMessage `json:"request_body,omitempty"`
+}
+
+// recordedHeaders are the response headers kept in fixtures.
+var recordedHeaders = []string{
+	"Content-Type",
+	"X-RateLimit-Limit",
+	"X-RateLimit-Remaining",
+	"X-RateLimit-Reset",
+}
+
+// fixtureKey identifies the fixtures of a request. The query is scrubbed by
+// s, so that no credential ends up in a file name.
+func fixtureKey(base string, s *Scrubber, req *http.Request) string {
+	if base == "" {
+		base = basePath
+	}
+	key := req.Method + "/" + strings.Trim(strings.TrimPrefix(req.URL.Path, base), "/")
+	if q := s.scrubQuery(req.URL.Query()).Encode(); q != "" {
+		key += "@" + q
+	}
+	return key
+}
+
+// fixtureFile returns the file name without extension of the seq-th fixture
+// of key, counting from 1.
+func fixtureFile(dir, key string, seq int) string {
+	name := filepath.Join(dir, filepath.FromSlash(key))
+	if seq > 1 {
+		name += "." + strconv.Itoa(seq)
+	}
+	return name
+}
+
+// readBody reads and replaces the body of a request or response, so that it
+// can be read again.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	b, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(b))
+	return b, err
+}
+
+// indent formats JSON like the fixtures in the repository, returning b
+// unchanged if it is not JSON.
+func indent(b []byte) []byte {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, b, "", "    "); err != nil {
+		return b
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
@@ -0,0 +1,152 @@
+package clickuptest
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// groupRequest is the body of the user group endpoints. Members is a list
+// of user IDs on create and an object with add and rem lists on update.
+type groupRequest struct {
+	Name    *string         `json:"name"`
+	Handle  *string         `json:"handle"`
+	Members json.RawMessage `json:"members"`
+}
+
+func (s *Server) group(id string) (*group, *Error) {
+	if g, ok := s.groups[id]; ok {
+		return g, nil
+	}
+	return nil, notFound("Group")
+}
+
+// getGroups lists the groups of the workspace team_id, or only those in
+// group_ids if given.
+func (s *Server) getGroups(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.r.URL.Query().Get("team_id"))
+	if err != nil {
+		return nil, err
+	}
+	var only map[string]bool
+	if v := c.r.URL.Query().Get("group_ids"); v != "" {
+		only = set(strings.Split(v, ","))
+	}
+	groups := []object{}
+	for _, id := range s.groupOrder {
+		g := s.groups[id]
+		if g.teamID != ws.id || only != nil && !only[g.id] {
+			continue
+		}
+		groups = append(groups, s.renderGroup(g))
+	}
+	return object{"groups": groups}, nil
+}
+
+func (s *Server) createGroup(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req groupRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Name == nil || *req.Name == "" {
+		return nil, badRequest("Group name invalid")
+	}
+	var members []int64
+	if len(req.Members) > 0 {
+		if err := json.Unmarshal(req.Members, &members); err != nil {
+			return nil, badRequest("Members invalid")
+		}
+	}
+	g := &group{id: s.newUUID(), teamID: ws.id, name: *req.Name, creator: s.User.ID, created: s.Now()}
+	if req.Handle != nil {
+		g.handle = *req.Handle
+	}
+	if err := s.addGroupMembers(ws, g, members); err != nil {
+		return nil, err
+	}
+	s.groups[g.id] = g
+	s.groupOrder = append(s.groupOrder, g.id)
+	return s.renderGroup(g), nil
+}
+
+func (s *Server) updateGroup(c *call) (interface{}, *Error) {
+	g, err := s.group(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req groupRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	var members struct {
+		Add []int64 `json:"add"`
+		Rem []int64 `json:"rem"`
+	}
+	if len(req.Members) > 0 {
+		if err := json.Unmarshal(req.Members, &members); err != nil {
+			return nil, badRequest("Members invalid")
+		}
+	}
+	if req.Name != nil {
+		if *req.Name == "" {
+			return nil, badRequest("Group name invalid")
+		}
+		g.name = *req.Name
+	}
+	if req.Handle != nil {
+		g.handle = *req.Handle
+	}
+	if err := s.addGroupMembers(s.workspaces[g.teamID], g, members.Add); err != nil {
+		return nil, err
+	}
+	for _, id := range members.Rem {
+		g.members = removeInt(g.members, id)
+	}
+	return s.renderGroup(g), nil
+}
+
+// addGroupMembers adds the users ids, which must be members of the workspace,
+// to a group.
+func (s *Server) addGroupMembers(ws *workspace, g *group, ids []int64) *Error {
+	for _, id := range ids {
+		if !containsInt(ws.members, id) {
+			return badRequest("User " + strconv.FormatInt(id, 10) + " is not a member of the workspace")
+		}
+		if !containsInt(g.members, id) {
+			g.members = append(g.members, id)
+		}
+	}
+	return nil
+}
+
+func (s *Server) deleteGroup(c *call) (interface{}, *Error) {
+	g, err := s.group(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	s.groupOrder = remove(s.groupOrder, g.id)
+	delete(s.groups, g.id)
+	return object{}, nil
+}
+
+func (s *Server) renderGroup(g *group) object {
+	members := make([]object, len(g.members))
+	for i, id := range g.members {
+		members[i] = s.renderUser(id)
+	}
+	return object{
+		"id":           g.id,
+		"team_id":      g.teamID,
+		"userid":       g.creator,
+		"name":         g.name,
+		"handle":       g.handle,
+		"date_created": millis(g.created),
+		"initials":     initials(g.name),
+		"members":      members,
+		"avatar":       object{"attachment_id": nil, "color": nil, "source": nil, "icon": nil},
+	}
+}
@@ -0,0 +1,232 @@
+package clickuptest
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// guestRequest is the body of Invite Guest and Edit Guest.
+type guestRequest struct {
+	Email               string `json:"email"`
+	Username            string `json:"username"`
+	CanEditTags         *bool  `json:"can_edit_tags"`
+	CanSeeTimeSpent     *bool  `json:"can_see_time_spent"`
+	CanSeeTimeEstimated *bool  `json:"can_see_time_estimated"`
+	CanCreateViews      *bool  `json:"can_create_views"`
+	CustomRoleID        int64  `json:"custom_role_id"`
+}
+
+// guest returns the guest id of the workspace teamID.
+func (s *Server) guest(teamID, id string) (*guest, *Error) {
+	userID, _ := strconv.ParseInt(id, 10, 64)
+	if g, ok := s.guests[userID]; ok && g.teamID == teamID {
+		return g, nil
+	}
+	return nil, notFound("Guest")
+}
+
+func (s *Server) inviteGuest(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req guestRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if !strings.Contains(req.Email, "@") {
+		return nil, badRequest("Email invalid")
+	}
+	if s.memberByEmail(ws, req.Email) != nil {
+		return nil, badRequest("User is already a member of the workspace")
+	}
+	s.nextID++
+	u := &User{ID: s.nextID, Username: req.Username, Email: req.Email, Color: "#7b68ee", Role: clickup.RoleGuest}
+	if u.Username == "" {
+		u.Username = req.Email[:strings.Index(req.Email, "@")]
+	}
+	s.users[u.ID] = u
+	ws.members = append(ws.members, u.ID)
+	g := &guest{userID: u.ID, teamID: ws.id, invitedBy: s.User.ID, invited: s.Now(), shared: make(map[string]map[string]clickup.Permission)}
+	applyGuest(g, &req)
+	s.guests[u.ID] = g
+	return object{"team": s.renderWorkspace(ws)}, nil
+}
+
+func (s *Server) getGuest(c *call) (interface{}, *Error) {
+	g, err := s.guest(c.ids[0], c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	return object{"guest": s.renderGuest(g)}, nil
+}
+
+func (s *Server) editGuest(c *call) (interface{}, *Error) {
+	g, err := s.guest(c.ids[0], c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	var req guestRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Username != "" {
+		s.users[g.userID].Username = req.Username
+	}
+	applyGuest(g, &req)
+	return object{"guest": s.renderGuest(g)}, nil
+}
+
+func applyGuest(g *guest, req *guestRequest) {
+	for _, f := range []struct {
+		v   *bool
+		dst *bool
+	}{
+		{req.CanEditTags, &g.canEditTags},
+		{req.CanSeeTimeSpent, &g.canSeeTimeSpent},
+		{req.CanSeeTimeEstimated, &g.canSeeTimeEstimated},
+		{req.CanCreateViews, &g.canCreateViews},
+	} {
+		if f.v != nil {
+			*f.dst = *f.v
+		}
+	}
+	if req.CustomRoleID != 0 {
+		g.customRoleID = req.CustomRoleID
+	}
+}
+
+func (s *Server) removeGuest(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.guest(ws.id, c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	ws.members = removeInt(ws.members, g.userID)
+	delete(s.guests, g.userID)
+	return object{"team": s.renderWorkspace(ws)}, nil
+}
+
+// shareTarget returns the workspace of the task, list or folder id, kind
+// being the first segment of the path.
+func (s *Server) shareTarget(kind, id string) (string, *Error) {
+	switch kind {
+	case "task":
+		t, err := s.task(id)
+		if err != nil {
+			return "", err
+		}
+		return s.teamOf(t), nil
+	case "list":
+		l, err := s.list(id)
+		if err != nil {
+			return "", err
+		}
+		return s.spaces[l.spaceID].teamID, nil
+	default:
+		f, err := s.folder(id)
+		if err != nil {
+			return "", err
+		}
+		return s.spaces[f.spaceID].teamID, nil
+	}
+}
+
+// shareWithGuest gives a guest a permission level on a task, list or
+// folder, or takes it away if remove is set.
+func (s *Server) shareWithGuest(c *call, remove bool) (interface{}, *Error) {
+	kind := splitPath(strings.TrimPrefix(c.r.URL.Path, basePath))[0]
+	teamID, err := s.shareTarget(kind, c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.guest(teamID, c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	if remove {
+		delete(g.shared[kind], c.ids[0])
+		return object{"guest": s.renderGuest(g)}, nil
+	}
+	var req struct {
+		PermissionLevel clickup.Permission `json:"permission_level"`
+	}
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	switch req.PermissionLevel {
+	case clickup.PermissionRead, clickup.PermissionComment, clickup.PermissionEdit, clickup.PermissionCreate:
+	default:
+		return nil, badRequest("Permission level invalid")
+	}
+	if g.shared[kind] == nil {
+		g.shared[kind] = make(map[string]clickup.Permission)
+	}
+	g.shared[kind][c.ids[0]] = req.PermissionLevel
+	return object{"guest": s.renderGuest(g)}, nil
+}
+
+func (s *Server) addGuestShare(c *call) (interface{}, *Error) {
+	return s.shareWithGuest(c, false)
+}
+
+func (s *Server) removeGuestShare(c *call) (interface{}, *Error) {
+	return s.shareWithGuest(c, true)
+}
+
+func (s *Server) renderGuest(g *guest) object {
+	user := s.renderUser(g.userID)
+	user["role"] = clickup.RoleGuest
+	user["custom_role"] = nil
+	if g.customRoleID != 0 {
+		user["custom_role"] = object{"id": g.customRoleID}
+	}
+	user["last_active"] = nil
+	user["date_joined"] = nil
+	user["date_invited"] = millis(g.invited)
+	return object{
+		"user":                   user,
+		"invited_by":             s.renderUser(g.invitedBy),
+		"can_see_time_spent":     g.canSeeTimeSpent,
+		"can_see_time_estimated": g.canSeeTimeEstimated,
+		"can_edit_tags":          g.canEditTags,
+		"can_create_views":       g.canCreateViews,
+		"shared": object{
+			"tasks":   s.renderShared(g, "task"),
+			"lists":   s.renderShared(g, "list"),
+			"folders": s.renderShared(g, "folder"),
+		},
+	}
+}
+
+// renderShared renders the objects of a kind shared with a guest, skipping
+// those deleted since.
+func (s *Server) renderShared(g *guest, kind string) []object {
+	ids := make([]string, 0, len(g.shared[kind]))
+	for id := range g.shared[kind] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	out := []object{}
+	for _, id := range ids {
+		var name string
+		switch {
+		case kind == "task" && s.tasks[id] != nil:
+			name = s.tasks[id].name
+		case kind == "list" && s.lists[id] != nil:
+			name = s.lists[id].name
+		case kind == "folder" && s.folders[id] != nil:
+			name = s.folders[id].name
+		default:
+			continue
+		}
+		out = append(out, object{"id": id, "name": name, "permission_level": g.shared[kind][id]})
+	}
+	return out
+}
@@ -0,0 +1,351 @@
+package clickuptest
+
+import "net/http"
+
+func (s *Server) getUser(c *call) (interface{}, *Error) {
+	u := s.renderUser(s.User.ID)
+	return object{"user": u}, nil
+}
+
+func (s *Server) getWorkspaces(c *call) (interface{}, *Error) {
+	teams := make([]object, 0, len(s.teamOrder))
+	for _, id := range s.teamOrder {
+		teams = append(teams, s.renderWorkspace(s.workspaces[id]))
+	}
+	return object{"teams": teams}, nil
+}
+
+func (s *Server) workspace(id string) (*workspace, *Error) {
+	if ws, ok := s.workspaces[id]; ok {
+		return ws, nil
+	}
+	return nil, &Error{http.StatusUnauthorized, "Team not authorized", "OAUTH_023"}
+}
+
+func (s *Server) space(id string) (*space, *Error) {
+	if sp, ok := s.spaces[id]; ok {
+		return sp, nil
+	}
+	return nil, notFound("Space")
+}
+
+func (s *Server) folder(id string) (*folder, *Error) {
+	if f, ok := s.folders[id]; ok {
+		return f, nil
+	}
+	return nil, notFound("Folder")
+}
+
+func (s *Server) list(id string) (*list, *Error) {
+	if l, ok := s.lists[id]; ok {
+		return l, nil
+	}
+	return nil, notFound("List")
+}
+
+// spaceRequest is the body of the space endpoints.
+type spaceRequest struct {
+	Name              *string `json:"name"`
+	MultipleAssignees *bool   `json:"multiple_assignees"`
+	Private           *bool   `json:"private"`
+	Archived          *bool   `json:"archived"`
+}
+
+func (s *Server) getSpaces(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	archived := c.flag("archived")
+	spaces := make([]object, 0, len(ws.spaces))
+	for _, id := range ws.spaces {
+		if sp := s.spaces[id]; sp.archived == archived {
+			spaces = append(spaces, s.renderSpace(sp))
+		}
+	}
+	return object{"spaces": spaces}, nil
+}
+
+func (s *Server) createSpaceHandler(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req spaceRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Name == nil || *req.Name == "" {
+		return nil, badRequest("Space name invalid")
+	}
+	sp := s.createSpace(ws.id, *req.Name, nil)
+	applySpace(sp, &req)
+	c.emit(s.spaceEvent("spaceCreated", sp))
+	return s.renderSpace(sp), nil
+}
+
+func (s *Server) getSpace(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.renderSpace(sp), nil
+}
+
+func (s *Server) updateSpace(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req spaceRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	applySpace(sp, &req)
+	c.emit(s.spaceEvent("spaceUpdated", sp))
+	return s.renderSpace(sp), nil
+}
+
+func applySpace(sp *space, req *spaceRequest) {
+	if req.Name != nil && *req.Name != "" {
+		sp.name = *req.Name
+	}
+	if req.MultipleAssignees != nil {
+		sp.multipleAssignees = *req.MultipleAssignees
+	}
+	if req.Private != nil {
+		sp.private = *req.Private
+	}
+	if req.Archived != nil {
+		sp.archived = *req.Archived
+	}
+}
+
+func (s *Server) deleteSpaceHandler(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	c.emit(s.spaceEvent("spaceDeleted", sp))
+	s.deleteSpace(sp)
+	return object{}, nil
+}
+
+// folderRequest is the body of the folder endpoints.
+type folderRequest struct {
+	Name     *string `json:"name"`
+	Archived *bool   `json:"archived"`
+}
+
+func (s *Server) getFolders(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	archived := c.flag("archived")
+	folders := make([]object, 0, len(sp.folders))
+	for _, id := range sp.folders {
+		if f := s.folders[id]; f.archived == archived {
+			folders = append(folders, s.renderFolder(f))
+		}
+	}
+	return object{"folders": folders}, nil
+}
+
+func (s *Server) createFolderHandler(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req folderRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Name == nil || *req.Name == "" {
+		return nil, badRequest("Folder name invalid")
+	}
+	f := s.createFolder(sp.id, *req.Name)
+	c.emit(s.folderEvent("folderCreated", f))
+	return s.renderFolder(f), nil
+}
+
+func (s *Server) getFolder(c *call) (interface{}, *Error) {
+	f, err := s.folder(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.renderFolder(f), nil
+}
+
+func (s *Server) updateFolder(c *call) (interface{}, *Error) {
+	f, err := s.folder(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req folderRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Name != nil && *req.Name != "" {
+		f.name = *req.Name
+	}
+	if req.Archived != nil {
+		f.archived = *req.Archived
+	}
+	c.emit(s.folderEvent("folderUpdated", f))
+	return s.renderFolder(f), nil
+}
+
+func (s *Server) deleteFolderHandler(c *call) (interface{}, *Error) {
+	f, err := s.folder(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	c.emit(s.folderEvent("folderDeleted", f))
+	s.deleteFolder(f)
+	return object{}, nil
+}
+
+// listRequest is the body of the list endpoints.
+type listRequest struct {
+	Name     *string `json:"name"`
+	Content  *string `json:"content"`
+	Archived *bool   `json:"archived"`
+}
+
+func (s *Server) renderLists(c *call, ids []string) object {
+	archived := c.flag("archived")
+	lists := make([]object, 0, len(ids))
+	for _, id := range ids {
+		if l := s.lists[id]; l.archived == archived {
+			lists = append(lists, s.renderList(l))
+		}
+	}
+	return object{"lists": lists}
+}
+
+func (s *Server) getFolderLists(c *call) (interface{}, *Error) {
+	f, err := s.folder(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.renderLists(c, f.lists), nil
+}
+
+func (s *Server) getFolderlessLists(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.renderLists(c, sp.lists), nil
+}
+
+func (s *Server) createFolderList(c *call) (interface{}, *Error) {
+	f, err := s.folder(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.createListHandler(c, f.spaceID, f.id)
+}
+
+func (s *Server) createFolderlessList(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.createListHandler(c, sp.id, "")
+}
+
+func (s *Server) createListHandler(c *call, spaceID, folderID string) (interface{}, *Error) {
+	var req listRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Name == nil || *req.Name == "" {
+		return nil, badRequest("List name invalid")
+	}
+	l := s.createList(spaceID, folderID, *req.Name)
+	if req.Content != nil {
+		l.content = *req.Content
+	}
+	c.emit(s.listEvent("listCreated", l))
+	return s.renderList(l), nil
+}
+
+func (s *Server) getList(c *call) (interface{}, *Error) {
+	l, err := s.list(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.renderList(l), nil
+}
+
+func (s *Server) updateList(c *call) (interface{}, *Error) {
+	l, err := s.list(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req listRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Name != nil && *req.Name != "" {
+		l.name = *req.Name
+	}
+	if req.Content != nil {
+		l.content = *req.Content
+	}
+	if req.Archived != nil {
+		l.archived = *req.Archived
+	}
+	c.emit(s.listEvent("listUpdated", l))
+	return s.renderList(l), nil
+}
+
+func (s *Server) deleteListHandler(c *call) (interface{}, *Error) {
+	l, err := s.list(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	c.emit(s.listEvent("listDeleted", l))
+	s.deleteList(l)
+	return object{}, nil
+}
+
+func (s *Server) renderViews(parentID string) object {
+	views := []object{}
+	for _, v := range s.views {
+		if v.parentID == parentID {
+			views = append(views, s.renderView(v))
+		}
+	}
+	return object{"views": views}
+}
+
+func (s *Server) getTeamViews(c *call) (interface{}, *Error) {
+	if _, err := s.workspace(c.ids[0]); err != nil {
+		return nil, err
+	}
+	return s.renderViews(c.ids[0]), nil
+}
+
+func (s *Server) getSpaceViews(c *call) (interface{}, *Error) {
+	if _, err := s.space(c.ids[0]); err != nil {
+		return nil, err
+	}
+	return s.renderViews(c.ids[0]), nil
+}
+
+func (s *Server) getFolderViews(c *call) (interface{}, *Error) {
+	if _, err := s.folder(c.ids[0]); err != nil {
+		return nil, err
+	}
+	return s.renderViews(c.ids[0]), nil
+}
+
+func (s *Server) getListViews(c *call) (interface{}, *Error) {
+	if _, err := s.list(c.ids[0]); err != nil {
+		return nil, err
+	}
+	return s.renderViews(c.ids[0]), nil
+}
@@ -0,0 +1,569 @@
+package clickuptest
+
+import (
+	"strings"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Task describes a task to seed with AddTask.
+type Task struct {
+	Name        string
+	Description string
+	// Status defaults to the first status of the space.
+	Status string
+	// Priority is 1 (urgent) to 4 (low), or 0 for none.
+	Priority     int
+	Assignees    []int64
+	Tags         []string
+	Parent       string
+	DueDate      time.Time
+	StartDate    time.Time
+	TimeEstimate time.Duration
+	Archived     bool
+}
+
+// CustomField describes a custom field to seed with AddCustomField.
+type CustomField struct {
+	Name string
+	// Type is a ClickUp field type such as "text", "number", "drop_down"
+	// or "date".
+	Type       string
+	TypeConfig interface{}
+	Required   bool
+}
+
+// View describes a view to seed with AddView.
+type View struct {
+	Name string
+	// Type is a ClickUp view type such as "list", "board" or "calendar".
+	Type string
+}
+
+// Status is a task status of a space.
+type Status struct {
+	Status string
+	// Type is "open", "custom", "done" or "closed". Tasks in a "closed"
+	// status are only listed with include_closed.
+	Type  string
+	Color string
+}
+
+// DefaultStatuses are the statuses of spaces created without any.
+var DefaultStatuses = []Status{
+	{Status: "to do", Type: "open", Color: "#d3d3d3"},
+	{Status: "in progress", Type: "custom", Color: "#4194f6"},
+	{Status: "complete", Type: "closed", Color: "#6bc950"},
+}
+
+type workspace struct {
+	id        string
+	name      string
+	color     string
+	members   []int64
+	spaces    []string
+	entryTags []*tag
+	// customRoles maps members to the ID of their custom role.
+	customRoles map[int64]int64
+}
+
+type space struct {
+	id                string
+	teamID            string
+	name              string
+	private           bool
+	multipleAssignees bool
+	archived          bool
+	statuses          []Status
+	folders           []string
+	lists             []string
+	tags              []*tag
+}
+
+type tag struct {
+	name    string
+	fg      string
+	bg      string
+	creator int64
+}
+
+type folder struct {
+	id       string
+	spaceID  string
+	name     string
+	hidden   bool
+	archived bool
+	lists    []string
+}
+
+type list struct {
+	id       string
+	spaceID  string
+	folderID string
+	name     string
+	content  string
+	archived bool
+	fields   []string
+}
+
+type task struct {
+	id           string
+	listID       string
+	name         string
+	description  string
+	status       string
+	priority     int
+	assignees    []int64
+	tags         []string
+	parent       string
+	creator      int64
+	created      time.Time
+	updated      time.Time
+	closed       time.Time
+	dueDate      time.Time
+	startDate    time.Time
+	timeEstimate time.Duration
+	archived     bool
+	values       map[string]interface{}
+	// history is the statuses t entered, oldest first.
+	history []statusChange
+}
+
+// statusChange records a task entering status at a time.
+type statusChange struct {
+	status string
+	at     time.Time
+}
+
+type comment struct {
+	id       string
+	taskID   string
+	listID   string
+	text     string
+	user     int64
+	assignee int64
+	resolved bool
+	date     time.Time
+}
+
+type view struct {
+	id         string
+	parentID   string
+	parentType int
+	name       string
+	typ        string
+}
+
+// dependency is a task waiting on another one.
+type dependency struct {
+	taskID    string
+	dependsOn string
+	user      int64
+	created   time.Time
+}
+
+type link struct {
+	taskID  string
+	linkID  string
+	user    int64
+	created time.Time
+}
+
+// entry is a time entry, which the legacy time tracking endpoints call an
+// interval. end is zero while the timer runs.
+type entry struct {
+	id          string
+	teamID      string
+	taskID      string
+	user        int64
+	description string
+	billable    bool
+	tags        []string
+	start       time.Time
+	end         time.Time
+	source      string
+	at          time.Time
+}
+
+type group struct {
+	id      string
+	teamID  string
+	name    string
+	handle  string
+	creator int64
+	created time.Time
+	members []int64
+}
+
+type guest struct {
+	userID              int64
+	teamID              string
+	invitedBy           int64
+	invited             time.Time
+	canEditTags         bool
+	canSeeTimeSpent     bool
+	canSeeTimeEstimated bool
+	canCreateViews      bool
+	customRoleID        int64
+	// shared maps "task", "list" and "folder" IDs shared with the guest to
+	// their permission level.
+	shared map[string]map[string]clickup.Permission
+}
+
+// template is a task, list or folder template. A task template creates a
+// copy of task, a list template a list with copies of tasks and a folder
+// template a folder with empty lists named after lists.
+type template struct {
+	id     string
+	teamID string
+	kind   string
+	name   string
+	task   Task
+	tasks  []Task
+	lists  []string
+}
+
+type field struct {
+	id         string
+	listID     string
+	name       string
+	typ        string
+	typeConfig interface{}
+	required   bool
+	created    time.Time
+}
+
+// AddUser adds u as a member of the workspace teamID. A zero u.ID is
+// replaced by a new ID, which is returned.
+func (s *Server) AddUser(teamID string, u User) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u.ID == 0 {
+		s.nextID++
+		u.ID = s.nextID
+	}
+	if u.Role == 0 {
+		u.Role = clickup.RoleMember
+	}
+	s.users[u.ID] = &u
+	if ws, ok := s.workspaces[teamID]; ok {
+		ws.members = append(ws.members, u.ID)
+	}
+	return u.ID
+}
+
+// AddWorkspace adds a workspace with User as its owner and returns its ID.
+func (s *Server) AddWorkspace(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ws := &workspace{id: s.newID(), name: name, color: "#7b68ee", members: []int64{s.User.ID}}
+	s.workspaces[ws.id] = ws
+	s.teamOrder = append(s.teamOrder, ws.id)
+	return ws.id
+}
+
+// AddSpace adds a space with DefaultStatuses to the workspace teamID and
+// returns its ID.
+func (s *Server) AddSpace(teamID, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.createSpace(teamID, name, nil).id
+}
+
+// AddFolder adds a folder to the space spaceID and returns its ID.
+func (s *Server) AddFolder(spaceID, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.createFolder(spaceID, name).id
+}
+
+// AddList adds a list to the folder folderID of the space spaceID, or a
+// folderless list if folderID is empty, and returns its ID.
+func (s *Server) AddList(spaceID, folderID, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.createList(spaceID, folderID, name).id
+}
+
+// AddTask adds t to the list listID and returns its ID.
+func (s *Server) AddTask(listID string, t Task) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.lists[listID]
+	if l == nil {
+		panic("clickuptest: unknown list " + listID)
+	}
+	return s.seedTask(l, t).id
+}
+
+// AddTaskTemplate adds a task template to the workspace teamID and returns
+// its ID. Tasks created from it are copies of t.
+func (s *Server) AddTaskTemplate(teamID, name string, t Task) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addTemplate(&template{teamID: teamID, kind: "task", name: name, task: t})
+}
+
+// AddListTemplate adds a list template to the workspace teamID and returns
+// its ID. Lists created from it hold copies of tasks.
+func (s *Server) AddListTemplate(teamID, name string, tasks ...Task) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addTemplate(&template{teamID: teamID, kind: "list", name: name, tasks: tasks})
+}
+
+// AddFolderTemplate adds a folder template to the workspace teamID and
+// returns its ID. Folders created from it hold an empty list for each name
+// in lists.
+func (s *Server) AddFolderTemplate(teamID, name string, lists ...string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addTemplate(&template{teamID: teamID, kind: "folder", name: name, lists: lists})
+}
+
+func (s *Server) addTemplate(tp *template) string {
+	tp.id = "t-" + s.newID()
+	s.templates[tp.id] = tp
+	s.templateOrder = append(s.templateOrder, tp.id)
+	return tp.id
+}
+
+// seedTask creates a task in l as described by t.
+func (s *Server) seedTask(l *list, t Task) *task {
+	tk := s.createTask(l, s.User.ID)
+	tk.name = t.Name
+	tk.description = t.Description
+	tk.priority = t.Priority
+	tk.assignees = t.Assignees
+	tk.tags = t.Tags
+	tk.parent = t.Parent
+	tk.dueDate = t.DueDate
+	tk.startDate = t.StartDate
+	tk.timeEstimate = t.TimeEstimate
+	tk.archived = t.Archived
+	if t.Status != "" {
+		s.setStatus(tk, t.Status)
+	}
+	return tk
+}
+
+// AddComment adds a comment by User to the task taskID and returns its ID.
+func (s *Server) AddComment(taskID, text string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cm := &comment{id: s.newID(), taskID: taskID, text: text, user: s.User.ID, date: s.Now()}
+	s.comments[cm.id] = cm
+	return cm.id
+}
+
+// AddCustomField adds f to the list listID and returns its ID.
+func (s *Server) AddCustomField(listID string, f CustomField) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.lists[listID]
+	if l == nil {
+		panic("clickuptest: unknown list " + listID)
+	}
+	fd := &field{id: s.newUUID(), listID: listID, name: f.Name, typ: f.Type, typeConfig: f.TypeConfig, required: f.Required, created: s.Now()}
+	s.fields[fd.id] = fd
+	l.fields = append(l.fields, fd.id)
+	return fd.id
+}
+
+// AddView adds v to the workspace, space, folder or list parentID and
+// returns its ID.
+func (s *Server) AddView(parentID string, v View) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The parent types are those of the ClickUp API.
+	var parentType int
+	switch {
+	case s.workspaces[parentID] != nil:
+		parentType = 7
+	case s.spaces[parentID] != nil:
+		parentType = 4
+	case s.folders[parentID] != nil:
+		parentType = 5
+	case s.lists[parentID] != nil:
+		parentType = 6
+	default:
+		panic("clickuptest: unknown view parent " + parentID)
+	}
+	vw := &view{id: s.newID(), parentID: parentID, parentType: parentType, name: v.Name, typ: v.Type}
+	s.views = append(s.views, vw)
+	return vw.id
+}
+
+func (s *Server) createSpace(teamID, name string, statuses []Status) *space {
+	if statuses == nil {
+		statuses = DefaultStatuses
+	}
+	sp := &space{id: s.newID(), teamID: teamID, name: name, statuses: statuses}
+	s.spaces[sp.id] = sp
+	if ws, ok := s.workspaces[teamID]; ok {
+		ws.spaces = append(ws.spaces, sp.id)
+	}
+	return sp
+}
+
+func (s *Server) createFolder(spaceID, name string) *folder {
+	f := &folder{id: s.newID(), spaceID: spaceID, name: name}
+	s.folders[f.id] = f
+	if sp, ok := s.spaces[spaceID]; ok {
+		sp.folders = append(sp.folders, f.id)
+	}
+	return f
+}
+
+func (s *Server) createList(spaceID, folderID, name string) *list {
+	l := &list{id: s.newID(), spaceID: spaceID, folderID: folderID, name: name}
+	s.lists[l.id] = l
+	if f, ok := s.folders[folderID]; ok {
+		f.lists = append(f.lists, l.id)
+	} else if sp, ok := s.spaces[spaceID]; ok {
+		sp.lists = append(sp.lists, l.id)
+	}
+	return l
+}
+
+func (s *Server) createTask(l *list, creator int64) *task {
+	now := s.Now()
+	t := &task{
+		id:      s.newTaskID(),
+		listID:  l.id,
+		creator: creator,
+		created: now,
+		updated: now,
+		values:  make(map[string]interface{}),
+	}
+	if sp := s.spaces[l.spaceID]; sp != nil && len(sp.statuses) > 0 {
+		t.status = sp.statuses[0].Status
+		t.history = []statusChange{{t.status, now}}
+	}
+	s.tasks[t.id] = t
+	s.taskOrder = append(s.taskOrder, t.id)
+	return t
+}
+
+// statusOf returns the status of the space of t named name, ignoring case
+// like ClickUp, and its index.
+func (s *Server) statusOf(t *task, name string) (Status, int, bool) {
+	if l := s.lists[t.listID]; l != nil {
+		if sp := s.spaces[l.spaceID]; sp != nil {
+			for i, st := range sp.statuses {
+				if strings.EqualFold(st.Status, name) {
+					return st, i, true
+				}
+			}
+		}
+	}
+	return Status{}, 0, false
+}
+
+// setStatus moves t to the status name, tracking when it entered it and
+// when it was closed.
+func (s *Server) setStatus(t *task, name string) bool {
+	st, _, ok := s.statusOf(t, name)
+	if !ok {
+		return false
+	}
+	if st.Status != t.status {
+		t.history = append(t.history, statusChange{st.Status, s.Now()})
+	}
+	t.status = st.Status
+	if st.Type == "closed" {
+		if t.closed.IsZero() {
+			t.closed = s.Now()
+		}
+	} else {
+		t.closed = time.Time{}
+	}
+	return true
+}
+
+func (s *Server) deleteSpace(sp *space) {
+	for _, id := range sp.folders {
+		if f := s.folders[id]; f != nil {
+			s.deleteFolder(f)
+		}
+	}
+	for _, id := range sp.lists {
+		if l := s.lists[id]; l != nil {
+			s.deleteList(l)
+		}
+	}
+	if ws := s.workspaces[sp.teamID]; ws != nil {
+		ws.spaces = remove(ws.spaces, sp.id)
+	}
+	delete(s.spaces, sp.id)
+}
+
+func (s *Server) deleteFolder(f *folder) {
+	for _, id := range f.lists {
+		if l := s.lists[id]; l != nil {
+			s.deleteList(l)
+		}
+	}
+	if sp := s.spaces[f.spaceID]; sp != nil {
+		sp.folders = remove(sp.folders, f.id)
+	}
+	delete(s.folders, f.id)
+}
+
+func (s *Server) deleteList(l *list) {
+	for _, id := range append([]string(nil), s.taskOrder...) {
+		if t := s.tasks[id]; t != nil && t.listID == l.id {
+			s.deleteTask(t)
+		}
+	}
+	for _, id := range l.fields {
+		delete(s.fields, id)
+	}
+	for id, cm := range s.comments {
+		if cm.listID == l.id {
+			delete(s.comments, id)
+		}
+	}
+	if f := s.folders[l.folderID]; f != nil {
+		f.lists = remove(f.lists, l.id)
+	} else if sp := s.spaces[l.spaceID]; sp != nil {
+		sp.lists = remove(sp.lists, l.id)
+	}
+	delete(s.lists, l.id)
+}
+
+// deleteTask deletes t with its comments and subtasks.
+func (s *Server) deleteTask(t *task) {
+	for _, id := range append([]string(nil), s.taskOrder...) {
+		if sub := s.tasks[id]; sub != nil && sub.parent == t.id {
+			s.deleteTask(sub)
+		}
+	}
+	for id, cm := range s.comments {
+		if cm.taskID == t.id {
+			delete(s.comments, id)
+		}
+	}
+	for id, e := range s.entries {
+		if e.taskID == t.id {
+			s.entryOrder = remove(s.entryOrder, id)
+			delete(s.entries, id)
+		}
+	}
+	s.removeDependencies(func(d *dependency) bool { return d.taskID == t.id || d.dependsOn == t.id })
+	s.removeLinks(func(l *link) bool { return l.taskID == t.id || l.linkID == t.id })
+	s.taskOrder = remove(s.taskOrder, t.id)
+	delete(s.tasks, t.id)
+}
+
+// remove returns ids without id, reusing its backing array.
+func remove(ids []string, id string) []string {
+	out := ids[:0]
+	for _, v := range ids {
+		if v != id {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,135 @@
+package clickuptest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// RecordingTransport records the requests it sends and their responses as
+// fixtures in Dir for ReplayTransport to serve. Fixtures are laid out by
+// method and endpoint path relative to the base URL:
+//
+//	fixtures/GET/team.json
+//	fixtures/GET/list/901/task@include_closed=true&page=1.json
+//	fixtures/PUT/task/86abc.json
+//	fixtures/PUT/task/86abc.2.json
+//
+// A .json file holds the response body. The .meta.json file next to it holds
+// the status code, the rate limit headers and the request body; without it a
+// fixture is a 200 response. The query string, if any, follows an @, and the
+// second and later identical requests get a sequence number.
+//
+// Bodies and query strings are scrubbed by Scrubber before they are written;
+// the caller still gets the original response. In query strings the values
+// of its Keys all become REDACTED.
+//
+// It should wrap the transport that sets the credentials, so that they are
+// never seen, and is used to regenerate fixtures against a sandbox
+// workspace:
+//
+//	rec := &clickuptest.RecordingTransport{
+//		Dir:       "fixtures",
+//		Transport: &clickup.PersonalTokenTransport{PersonalToken: token},
+//	}
+//	client := clickup.NewClient(rec.Client())
+//
+// Recording overwrites the fixtures of the requests it sends but leaves the
+// others, so Dir should be emptied first to drop fixtures no longer used.
+type RecordingTransport struct {
+	Dir string
+
+	// Scrubber scrubs the request and response bodies. It defaults to a
+	// Scrubber with DefaultScrubKeys.
+	Scrubber *Scrubber
+
+	// BasePath is the path of the API base URL. It defaults to "/api/v2/".
+	BasePath string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu     sync.Mutex
+	once   sync.Once
+	counts map[string]int
+}
+
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Read the body of a copy, leaving req untouched as RoundTripper
+	// requires.
+	out := req.Clone(req.Context())
+	reqBody, err := readBody(&out.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport().RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.record(req, reqBody, resp, respBody); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Client returns an *http.Client using the transport.
+func (t *RecordingTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *RecordingTransport) record(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte) error {
+	t.once.Do(func() {
+		if t.Scrubber == nil {
+			t.Scrubber = &Scrubber{}
+		}
+	})
+	key := fixtureKey(t.BasePath, t.Scrubber, req)
+	t.mu.Lock()
+	if t.counts == nil {
+		t.counts = make(map[string]int)
+	}
+	t.counts[key]++
+	seq := t.counts[key]
+	t.mu.Unlock()
+
+	meta := fixtureMeta{Status: resp.StatusCode, Header: make(http.Header)}
+	for _, h := range recordedHeaders {
+		if v := resp.Header.Get(h); v != "" {
+			meta.Header.Set(h, v)
+		}
+	}
+	if len(reqBody) > 0 {
+		meta.RequestBody = t.Scrubber.Scrub(reqBody)
+	}
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	name := fixtureFile(t.Dir, key, seq)
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(name+".json", indent(t.Scrubber.Scrub(respBody)), 0o644); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(name+".meta.json", indent(metaJSON), 0o644)
+}
+
+func (t *RecordingTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+
+	return http.DefaultTransport
+}
@@ -0,0 +1,141 @@
+package clickuptest_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+func TestRecordReplay(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	srv.User.Email = "jane@acme.test"
+	team := srv.AddWorkspace("Acme")
+	space := srv.AddSpace(team, "Engineering")
+	list := srv.AddList(space, "", "Backlog")
+	task := srv.AddTask(list, clickuptest.Task{Name: "Write tests"})
+
+	dir, err := ioutil.TempDir("", "clickuptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	rec := &clickuptest.RecordingTransport{
+		Dir: dir,
+		Transport: &clickup.PersonalTokenTransport{
+			PersonalToken: srv.Token,
+			Transport:     srv.Server.Client().Transport,
+		},
+	}
+	live := clickup.NewClient(rec.Client())
+	live.BaseURL = srv.BaseURL()
+
+	ctx := context.Background()
+	if _, _, err := live.Workspaces.Get(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, _, err := live.Tasks.List(ctx, list, "?page=0"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "GET", "team.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "jane@acme.test") || !strings.Contains(string(b), "user1@example.com") {
+		t.Errorf("email not scrubbed:\n%s", b)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "GET", "list", list, "task@page=0.2.json")); err != nil {
+		t.Errorf("second request not recorded: %v", err)
+	}
+
+	replay := clickup.NewClient((&clickuptest.ReplayTransport{Dir: dir}).Client())
+	tasks, _, err := replay.Tasks.List(ctx, list, "?page=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks.Tasks) != 1 || tasks.Tasks[0].ID != task {
+		t.Errorf("replayed tasks = %+v", tasks.Tasks)
+	}
+	replay.Tasks.List(ctx, list, "?page=0")
+	_, _, err = replay.Tasks.List(ctx, list, "?page=0")
+	if !errors.Is(err, clickuptest.ErrNoFixture) {
+		t.Errorf("third strict replay: %v, want ErrNoFixture", err)
+	}
+
+	lenient := clickup.NewClient((&clickuptest.ReplayTransport{Dir: dir, Mode: clickuptest.Lenient}).Client())
+	for _, q := range []string{"?page=0", "?page=0", "?page=0"} {
+		if _, _, err := lenient.Tasks.List(ctx, list, q); err != nil {
+			t.Errorf("lenient replay %q: %v", q, err)
+		}
+	}
+}
+
+func TestReplayRepositoryFixtures(t *testing.T) {
+	client := clickup.NewClient((&clickuptest.ReplayTransport{Dir: "../../fixtures"}).Client())
+	ws, _, err := client.Workspaces.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ws.Workspaces) != 1 || ws.Workspaces[0].ID != "8591733" {
+		t.Errorf("workspaces = %+v", ws.Workspaces)
+	}
+}
+
+func TestRecordScrubsQuery(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	dir, err := ioutil.TempDir("", "clickuptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	rec := &clickuptest.RecordingTransport{Dir: dir, Transport: srv.Server.Client().Transport}
+	live := clickup.NewClient(rec.Client())
+	live.BaseURL = srv.BaseURL()
+	const query = "oauth/token?client_id=app&client_secret=s3cr3t-value&code=auth-code-123&note=pk_12345_ABCDE"
+
+	ctx := context.Background()
+	req, err := live.NewRequest("POST", query, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The fake has no OAuth endpoint; the error response is recorded all the
+	// same.
+	live.Do(ctx, req, nil)
+
+	var names []string
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		names = append(names, path)
+		return err
+	})
+	for _, name := range names {
+		for _, secret := range []string{"s3cr3t-value", "auth-code-123", "pk_12345"} {
+			if strings.Contains(name, secret) {
+				t.Errorf("fixture %s contains %s", name, secret)
+			}
+		}
+	}
+	want := filepath.Join(dir, "POST", "oauth", "token@client_id=app&client_secret=REDACTED&code=REDACTED&note=pk_REDACTED.json")
+	if _, err := os.Stat(want); err != nil {
+		t.Fatalf("fixture not recorded as %s: %v (have %q)", want, err, names)
+	}
+
+	// Replaying the request with other secrets finds the same fixture.
+	replay := clickup.NewClient((&clickuptest.ReplayTransport{Dir: dir}).Client())
+	req, _ = replay.NewRequest("POST", "oauth/token?client_id=app&client_secret=other&code=other&note=pk_67890_FGHIJ", nil)
+	_, err = replay.Do(ctx, req, nil)
+	var ferr *clickuptest.FixtureError
+	if errors.As(err, &ferr) {
+		t.Errorf("replay did not find the fixture: %v", err)
+	}
+}
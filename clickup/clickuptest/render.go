@@ -0,0 +1,267 @@
+package clickuptest
+
+import (
+	"strconv"
+	"strings"
+)
+
+// object is a JSON object in a response.
+type object = map[string]interface{}
+
+var priorities = []object{
+	nil,
+	{"id": "1", "priority": "urgent", "color": "#f50000", "orderindex": "1"},
+	{"id": "2", "priority": "high", "color": "#ffcc00", "orderindex": "2"},
+	{"id": "3", "priority": "normal", "color": "#6fddff", "orderindex": "3"},
+	{"id": "4", "priority": "low", "color": "#d8d8d8", "orderindex": "4"},
+}
+
+func (s *Server) renderUser(id int64) object {
+	u, ok := s.users[id]
+	if !ok && id == s.User.ID {
+		u = &s.User
+	}
+	if u == nil {
+		return object{"id": id}
+	}
+	return object{
+		"id":             u.ID,
+		"username":       u.Username,
+		"email":          u.Email,
+		"color":          u.Color,
+		"initials":       initials(u.Username),
+		"profilePicture": nil,
+	}
+}
+
+func initials(name string) string {
+	var b strings.Builder
+	for _, f := range strings.Fields(name) {
+		b.WriteString(strings.ToUpper(f[:1]))
+	}
+	return b.String()
+}
+
+func (s *Server) renderWorkspace(ws *workspace) object {
+	members := make([]object, 0, len(ws.members))
+	for _, id := range ws.members {
+		members = append(members, s.renderMember(ws, id))
+	}
+	return object{"id": ws.id, "name": ws.name, "color": ws.color, "avatar": nil, "members": members}
+}
+
+func renderStatuses(statuses []Status) []object {
+	out := make([]object, len(statuses))
+	for i, st := range statuses {
+		out[i] = object{"status": st.Status, "type": st.Type, "orderindex": i, "color": st.Color}
+	}
+	return out
+}
+
+func (s *Server) renderSpace(sp *space) object {
+	return object{
+		"id":                 sp.id,
+		"name":               sp.name,
+		"private":            sp.private,
+		"statuses":           renderStatuses(sp.statuses),
+		"multiple_assignees": sp.multipleAssignees,
+		"features":           object{"due_dates": object{"enabled": true}, "time_tracking": object{"enabled": true}, "tags": object{"enabled": true}, "custom_fields": object{"enabled": true}},
+		"archived":           sp.archived,
+	}
+}
+
+func (s *Server) renderFolder(f *folder) object {
+	sp := s.spaces[f.spaceID]
+	lists := make([]object, 0, len(f.lists))
+	count := 0
+	for _, id := range f.lists {
+		l := s.lists[id]
+		lists = append(lists, s.renderList(l))
+		count += s.taskCount(l)
+	}
+	return object{
+		"id":                f.id,
+		"name":              f.name,
+		"orderindex":        indexOf(sp.folders, f.id),
+		"override_statuses": false,
+		"hidden":            f.hidden,
+		"space":             object{"id": sp.id, "name": sp.name, "access": true},
+		"task_count":        strconv.Itoa(count),
+		"archived":          f.archived,
+		"statuses":          renderStatuses(sp.statuses),
+		"lists":             lists,
+		"permission_level":  "create",
+	}
+}
+
+func (s *Server) renderList(l *list) object {
+	sp := s.spaces[l.spaceID]
+	folder := object{"id": "", "name": "hidden", "hidden": true, "access": true}
+	order := sp.lists
+	if f := s.folders[l.folderID]; f != nil {
+		folder = object{"id": f.id, "name": f.name, "hidden": f.hidden, "access": true}
+		order = f.lists
+	}
+	return object{
+		"id":                l.id,
+		"name":              l.name,
+		"deleted":           false,
+		"orderindex":        indexOf(order, l.id),
+		"content":           l.content,
+		"status":            nil,
+		"priority":          nil,
+		"assignee":          nil,
+		"task_count":        s.taskCount(l),
+		"due_date":          nil,
+		"start_date":        nil,
+		"folder":            folder,
+		"space":             object{"id": sp.id, "name": sp.name, "access": true},
+		"archived":          l.archived,
+		"override_statuses": false,
+		"statuses":          renderStatuses(sp.statuses),
+		"permission_level":  "create",
+	}
+}
+
+func (s *Server) taskCount(l *list) int {
+	n := 0
+	for _, t := range s.tasks {
+		if t.listID == l.id && !t.archived {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *Server) renderField(f *field) object {
+	return object{
+		"id":               f.id,
+		"name":             f.name,
+		"type":             f.typ,
+		"type_config":      f.typeConfig,
+		"date_created":     millis(f.created),
+		"hide_from_guests": false,
+		"required":         f.required,
+	}
+}
+
+func renderTag(tg *tag) object {
+	return object{"name": tg.name, "tag_fg": tg.fg, "tag_bg": tg.bg, "creator": tg.creator}
+}
+
+func (s *Server) renderView(v *view) object {
+	return object{
+		"id":   v.id,
+		"name": v.name,
+		"type": v.typ,
+		"parent": object{
+			"id":   v.parentID,
+			"type": v.parentType,
+		},
+	}
+}
+
+func (s *Server) renderTask(t *task) object {
+	l := s.lists[t.listID]
+	st, index, _ := s.statusOf(t, t.status)
+	assignees := make([]object, len(t.assignees))
+	for i, id := range t.assignees {
+		assignees[i] = s.renderUser(id)
+	}
+	fields := make([]object, 0, len(l.fields))
+	for _, id := range l.fields {
+		f := s.renderField(s.fields[id])
+		if v, ok := t.values[id]; ok {
+			f["value"] = v
+		}
+		fields = append(fields, f)
+	}
+	var parent, estimate, spent interface{}
+	if t.parent != "" {
+		parent = t.parent
+	}
+	if t.timeEstimate > 0 {
+		estimate = t.timeEstimate.Milliseconds()
+	}
+	if d := s.timeSpent(t); d > 0 {
+		spent = d.Milliseconds()
+	}
+	creator := s.renderUser(t.creator)
+	delete(creator, "initials")
+	delete(creator, "email")
+	return object{
+		"id":           t.id,
+		"custom_id":    nil,
+		"name":         t.name,
+		"text_content": t.description,
+		"description":  t.description,
+		"status": object{
+			"status":     st.Status,
+			"type":       st.Type,
+			"orderindex": index,
+			"color":      st.Color,
+		},
+		"orderindex":    strconv.Itoa(indexOf(s.taskOrder, t.id)) + ".00000000000000000000000000000000",
+		"date_created":  millis(t.created),
+		"date_updated":  millis(t.updated),
+		"date_closed":   millis(t.closed),
+		"archived":      t.archived,
+		"creator":       creator,
+		"assignees":     assignees,
+		"checklists":    []object{},
+		"tags":          s.renderTaskTags(t),
+		"parent":        parent,
+		"priority":      priorities[t.priority],
+		"due_date":      millis(t.dueDate),
+		"start_date":    millis(t.startDate),
+		"time_estimate": estimate,
+		"time_spent":    spent,
+		"custom_fields": fields,
+		"dependencies":  s.renderDependencies(t),
+		"linked_tasks":  s.renderLinks(t),
+		"list":          object{"id": l.id},
+		"folder":        object{"id": l.folderID},
+		"space":         object{"id": l.spaceID},
+		"url":           "https://app.clickup.com/t/" + t.id,
+	}
+}
+
+func (s *Server) renderTaskTags(t *task) []object {
+	spaceID := s.lists[t.listID].spaceID
+	tags := make([]object, len(t.tags))
+	for i, name := range t.tags {
+		if tg := s.spaceTag(spaceID, name); tg != nil {
+			tags[i] = renderTag(tg)
+		} else {
+			tags[i] = object{"name": name, "tag_fg": "#000000", "tag_bg": "#d3d3d3", "creator": t.creator}
+		}
+	}
+	return tags
+}
+
+func (s *Server) renderComment(cm *comment) object {
+	var assignee interface{}
+	if cm.assignee != 0 {
+		assignee = s.renderUser(cm.assignee)
+	}
+	return object{
+		"id":           cm.id,
+		"comment":      []object{{"text": cm.text}},
+		"comment_text": cm.text,
+		"user":         s.renderUser(cm.user),
+		"resolved":     cm.resolved,
+		"assignee":     assignee,
+		"assigned_by":  nil,
+		"reactions":    []object{},
+		"date":         millis(cm.date),
+	}
+}
+
+func indexOf(ids []string, id string) int {
+	for i, v := range ids {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,148 @@
+package clickuptest
+
+import "net/http"
+
+type route struct {
+	method  string
+	pattern string
+	handle  func(*Server, *call) (interface{}, *Error)
+}
+
+// routes are the endpoints the fake serves. Patterns are relative to the base
+// URL with {id} matching any segment.
+var routes = []route{
+	{http.MethodGet, "user", (*Server).getUser},
+	{http.MethodGet, "team", (*Server).getWorkspaces},
+
+	{http.MethodGet, "team/{id}/space", (*Server).getSpaces},
+	{http.MethodPost, "team/{id}/space", (*Server).createSpaceHandler},
+	{http.MethodGet, "space/{id}", (*Server).getSpace},
+	{http.MethodPut, "space/{id}", (*Server).updateSpace},
+	{http.MethodDelete, "space/{id}", (*Server).deleteSpaceHandler},
+
+	{http.MethodGet, "space/{id}/tag", (*Server).getSpaceTags},
+	{http.MethodPost, "space/{id}/tag", (*Server).createSpaceTag},
+	{http.MethodPut, "space/{id}/tag/{id}", (*Server).editSpaceTag},
+	{http.MethodDelete, "space/{id}/tag/{id}", (*Server).deleteSpaceTag},
+
+	{http.MethodGet, "space/{id}/folder", (*Server).getFolders},
+	{http.MethodPost, "space/{id}/folder", (*Server).createFolderHandler},
+	{http.MethodGet, "folder/{id}", (*Server).getFolder},
+	{http.MethodPut, "folder/{id}", (*Server).updateFolder},
+	{http.MethodDelete, "folder/{id}", (*Server).deleteFolderHandler},
+
+	{http.MethodGet, "folder/{id}/list", (*Server).getFolderLists},
+	{http.MethodPost, "folder/{id}/list", (*Server).createFolderList},
+	{http.MethodGet, "space/{id}/list", (*Server).getFolderlessLists},
+	{http.MethodPost, "space/{id}/list", (*Server).createFolderlessList},
+	{http.MethodGet, "list/{id}", (*Server).getList},
+	{http.MethodPut, "list/{id}", (*Server).updateList},
+	{http.MethodDelete, "list/{id}", (*Server).deleteListHandler},
+
+	{http.MethodGet, "list/{id}/task", (*Server).getTasks},
+	{http.MethodPost, "list/{id}/task", (*Server).createTaskHandler},
+	{http.MethodGet, "team/{id}/task", (*Server).getTeamTasks},
+	{http.MethodGet, "task/{id}", (*Server).getTask},
+	{http.MethodPut, "task/{id}", (*Server).updateTask},
+	{http.MethodDelete, "task/{id}", (*Server).deleteTaskHandler},
+
+	{http.MethodPost, "task/{id}/tag/{id}", (*Server).addTaskTag},
+	{http.MethodDelete, "task/{id}/tag/{id}", (*Server).removeTaskTag},
+
+	{http.MethodPost, "task/{id}/dependency", (*Server).addDependency},
+	{http.MethodDelete, "task/{id}/dependency", (*Server).deleteDependency},
+	{http.MethodPost, "task/{id}/link/{id}", (*Server).addTaskLink},
+	{http.MethodDelete, "task/{id}/link/{id}", (*Server).deleteTaskLink},
+
+	{http.MethodGet, "task/bulk_time_in_status/task_ids", (*Server).getBulkTimeInStatus},
+	{http.MethodGet, "task/{id}/time_in_status", (*Server).getTimeInStatus},
+
+	{http.MethodGet, "task/{id}/time", (*Server).getTrackedTime},
+	{http.MethodPost, "task/{id}/time", (*Server).trackTime},
+	{http.MethodPut, "task/{id}/time/{id}", (*Server).editTrackedTime},
+	{http.MethodDelete, "task/{id}/time/{id}", (*Server).deleteTrackedTime},
+
+	{http.MethodGet, "team/{id}/time_entries/current", (*Server).getRunningEntry},
+	{http.MethodPost, "team/{id}/time_entries/start", (*Server).startEntry},
+	{http.MethodPost, "team/{id}/time_entries/stop", (*Server).stopEntry},
+	{http.MethodGet, "team/{id}/time_entries/tags", (*Server).getEntryTags},
+	{http.MethodPost, "team/{id}/time_entries/tags", (*Server).addEntryTags},
+	{http.MethodDelete, "team/{id}/time_entries/tags", (*Server).removeEntryTags},
+	{http.MethodPut, "team/{id}/time_entries/tags", (*Server).editEntryTag},
+	{http.MethodGet, "team/{id}/time_entries", (*Server).getEntries},
+	{http.MethodPost, "team/{id}/time_entries", (*Server).createEntry},
+	{http.MethodGet, "team/{id}/time_entries/{id}", (*Server).getEntry},
+	{http.MethodPut, "team/{id}/time_entries/{id}", (*Server).updateEntry},
+	{http.MethodDelete, "team/{id}/time_entries/{id}", (*Server).deleteEntryHandler},
+	{http.MethodGet, "team/{id}/time_entries/{id}/history", (*Server).getEntryHistory},
+
+	{http.MethodGet, "task/{id}/comment", (*Server).getTaskComments},
+	{http.MethodPost, "task/{id}/comment", (*Server).createTaskComment},
+	{http.MethodGet, "list/{id}/comment", (*Server).getListComments},
+	{http.MethodPost, "list/{id}/comment", (*Server).createListComment},
+	{http.MethodPut, "comment/{id}", (*Server).updateComment},
+	{http.MethodDelete, "comment/{id}", (*Server).deleteComment},
+
+	{http.MethodGet, "team/{id}/view", (*Server).getTeamViews},
+	{http.MethodGet, "space/{id}/view", (*Server).getSpaceViews},
+	{http.MethodGet, "folder/{id}/view", (*Server).getFolderViews},
+	{http.MethodGet, "list/{id}/view", (*Server).getListViews},
+
+	{http.MethodGet, "list/{id}/field", (*Server).getFields},
+	{http.MethodPost, "task/{id}/field/{id}", (*Server).setFieldValue},
+	{http.MethodDelete, "task/{id}/field/{id}", (*Server).removeFieldValue},
+
+	{http.MethodGet, "team/{id}/taskTemplate", (*Server).getTaskTemplates},
+	{http.MethodPost, "list/{id}/taskTemplate/{id}", (*Server).createTaskFromTemplate},
+	{http.MethodPost, "folder/{id}/list_template/{id}", (*Server).createFolderListFromTemplate},
+	{http.MethodPost, "space/{id}/list_template/{id}", (*Server).createFolderlessListFromTemplate},
+	{http.MethodPost, "space/{id}/folder_template/{id}", (*Server).createFolderFromTemplate},
+
+	{http.MethodGet, "group", (*Server).getGroups},
+	{http.MethodPost, "team/{id}/group", (*Server).createGroup},
+	{http.MethodPut, "group/{id}", (*Server).updateGroup},
+	{http.MethodDelete, "group/{id}", (*Server).deleteGroup},
+
+	{http.MethodPost, "team/{id}/user", (*Server).inviteUser},
+	{http.MethodGet, "team/{id}/user/{id}", (*Server).getMember},
+	{http.MethodPut, "team/{id}/user/{id}", (*Server).editMember},
+	{http.MethodDelete, "team/{id}/user/{id}", (*Server).removeMember},
+
+	{http.MethodPost, "team/{id}/guest", (*Server).inviteGuest},
+	{http.MethodGet, "team/{id}/guest/{id}", (*Server).getGuest},
+	{http.MethodPut, "team/{id}/guest/{id}", (*Server).editGuest},
+	{http.MethodDelete, "team/{id}/guest/{id}", (*Server).removeGuest},
+	{http.MethodPost, "task/{id}/guest/{id}", (*Server).addGuestShare},
+	{http.MethodDelete, "task/{id}/guest/{id}", (*Server).removeGuestShare},
+	{http.MethodPost, "list/{id}/guest/{id}", (*Server).addGuestShare},
+	{http.MethodDelete, "list/{id}/guest/{id}", (*Server).removeGuestShare},
+	{http.MethodPost, "folder/{id}/guest/{id}", (*Server).addGuestShare},
+	{http.MethodDelete, "folder/{id}/guest/{id}", (*Server).removeGuestShare},
+
+	{http.MethodGet, "team/{id}/webhook", (*Server).getWebhooks},
+	{http.MethodPost, "team/{id}/webhook", (*Server).createWebhook},
+	{http.MethodPut, "webhook/{id}", (*Server).updateWebhook},
+	{http.MethodDelete, "webhook/{id}", (*Server).deleteWebhook},
+}
+
+// match reports whether the route serves method and the path segments,
+// returning the values of the {id} segments.
+func (rt route) match(method string, segments []string) ([]string, bool) {
+	if rt.method != method {
+		return nil, false
+	}
+	pattern := splitPath(rt.pattern)
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	var ids []string
+	for i, p := range pattern {
+		switch {
+		case p == "{id}":
+			ids = append(ids, segments[i])
+		case p != segments[i]:
+			return nil, false
+		}
+	}
+	return ids, true
+}
@@ -0,0 +1,165 @@
+package clickuptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// DefaultScrubKeys are the JSON keys whose string values Scrubber replaces.
+// Each maps to the format of the placeholder, which is given a number that
+// is the same for every occurrence of the same value. A format without a
+// verb replaces every value with the same text.
+var DefaultScrubKeys = map[string]string{
+	"email":          "user%d@example.com",
+	"username":       "User %d",
+	"initials":       "U%d",
+	"profilePicture": "https://example.com/avatar/%d.png",
+	"secret":         "REDACTED",
+	"access_token":   "REDACTED",
+	"client_secret":  "REDACTED",
+	"code":           "REDACTED",
+}
+
+// Scrubber removes personal data and credentials from JSON bodies before
+// they are written to fixtures. The values of Keys are replaced by
+// placeholders, consistently so that the same email address becomes the same
+// placeholder in every fixture of a recording. Every other string goes
+// through clickup.Redact, which removes tokens.
+type Scrubber struct {
+	// Keys defaults to DefaultScrubKeys if nil.
+	Keys map[string]string
+
+	mu           sync.Mutex
+	placeholders map[string]string
+	counts       map[string]int
+}
+
+// Scrub returns body with personal data and credentials replaced. A body
+// that is not JSON is only passed through clickup.Redact.
+func (s *Scrubber) Scrub(body []byte) []byte {
+	v, ok := decodeJSON(body)
+	if !ok {
+		return []byte(clickup.Redact(string(body)))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := json.Marshal(s.walk(v, s.replace))
+	if err != nil {
+		return []byte(clickup.Redact(string(body)))
+	}
+	return b
+}
+
+// mask returns body with the values of Keys blanked and tokens redacted, to
+// compare a live body with a scrubbed one. It returns nil if body is not
+// JSON.
+func (s *Scrubber) mask(body []byte) interface{} {
+	v, ok := decodeJSON(body)
+	if !ok {
+		return nil
+	}
+	return s.walk(v, func(key, value string) string { return "" })
+}
+
+func (s *Scrubber) keys() map[string]string {
+	if s.Keys == nil {
+		return DefaultScrubKeys
+	}
+	return s.Keys
+}
+
+// walk returns v with the strings under Keys replaced by repl and the other
+// strings redacted.
+func (s *Scrubber) walk(v interface{}, repl func(key, value string) string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		// Visit keys in order so that placeholders are numbered the same way
+		// every time a fixture is recorded.
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			e := v[k]
+			if str, ok := e.(string); ok && str != "" {
+				if _, scrub := s.keys()[k]; scrub {
+					v[k] = repl(k, str)
+					continue
+				}
+			}
+			v[k] = s.walk(e, repl)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = s.walk(e, repl)
+		}
+		return v
+	case string:
+		return clickup.Redact(v)
+	}
+	return v
+}
+
+// replace returns the placeholder of value under key.
+func (s *Scrubber) replace(key, value string) string {
+	format := s.keys()[key]
+	id := key + "\x00" + value
+	if p, ok := s.placeholders[id]; ok {
+		return p
+	}
+	if s.placeholders == nil {
+		s.placeholders = make(map[string]string)
+		s.counts = make(map[string]int)
+	}
+	s.counts[key]++
+	p := format
+	if strings.ContainsRune(format, '%') {
+		p = fmt.Sprintf(format, s.counts[key])
+	}
+	s.placeholders[id] = p
+	return p
+}
+
+// scrubQuery returns q with the values of Keys replaced and the others
+// passed through clickup.Redact. Unlike in bodies, every value of a key
+// becomes the same text, so that a replayed request maps to the fixture it
+// was recorded in.
+func (s *Scrubber) scrubQuery(q url.Values) url.Values {
+	out := make(url.Values, len(q))
+	for k, vs := range q {
+		_, scrub := s.keys()[k]
+		for _, v := range vs {
+			if scrub && v != "" {
+				v = "REDACTED"
+			} else {
+				v = clickup.Redact(v)
+			}
+			out[k] = append(out[k], v)
+		}
+	}
+	return out
+}
+
+// decodeJSON decodes body keeping numbers as written, so that large IDs
+// survive.
+func decodeJSON(body []byte) (interface{}, bool) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, false
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+	return v, true
+}
@@ -0,0 +1,376 @@
+// Package clickuptest provides an in-memory fake of the ClickUp v2 API for
+// tests that should run offline.
+//
+// A Server holds workspaces, spaces, folders, lists, tasks, tags, comments,
+// custom fields, views, webhooks, task dependencies and links, time entries,
+// user groups and guests. They can be seeded with the Add methods and are
+// then read and changed through the HTTP API like on ClickUp:
+//
+//	srv := clickuptest.NewServer()
+//	defer srv.Close()
+//	team := srv.AddWorkspace("Acme")
+//	space := srv.AddSpace(team, "Engineering")
+//	list := srv.AddList(space, "", "Backlog")
+//	srv.AddTask(list, clickuptest.Task{Name: "Write tests"})
+//
+//	client := srv.Client()
+//	tasks, _, err := client.Tasks.List(ctx, list, "")
+//
+// Errors use ClickUp's body format, {"err": "...", "ECODE": "..."}. The
+// codes are stable for the fake but do not always match the ones ClickUp
+// sends.
+package clickuptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// basePath is the path the API is served under, like on api.clickup.com.
+const basePath = "/api/v2/"
+
+// Server is a fake ClickUp API server. Its exported fields may be changed
+// before the first request.
+type Server struct {
+	*httptest.Server
+
+	// Token is the personal token requests must carry in the Authorization
+	// header, with or without a "Bearer " prefix. Any non-empty token is
+	// accepted if Token is empty.
+	Token string
+
+	// User is the user the token belongs to. It is the creator of tasks and
+	// comments created through the API.
+	User User
+
+	// RateLimit is the number of requests allowed per minute, as on
+	// ClickUp's Free Forever plan by default. Zero disables rate limiting.
+	RateLimit int
+
+	// PageSize is the number of tasks or task templates returned per page.
+	PageSize int
+
+	// Now returns the current time. It defaults to time.Now and may be
+	// replaced to make dates deterministic.
+	Now func() time.Time
+
+	// WebhookClient delivers webhook events. It defaults to
+	// http.DefaultClient.
+	WebhookClient *http.Client
+
+	mu            sync.Mutex
+	nextID        int64
+	users         map[int64]*User
+	workspaces    map[string]*workspace
+	teamOrder     []string
+	spaces        map[string]*space
+	folders       map[string]*folder
+	lists         map[string]*list
+	tasks         map[string]*task
+	taskOrder     []string
+	comments      map[string]*comment
+	fields        map[string]*field
+	views         []*view
+	webhooks      map[string]*webhook
+	dependencies  []*dependency
+	links         []*link
+	entries       map[string]*entry
+	entryOrder    []string
+	groups        map[string]*group
+	groupOrder    []string
+	guests        map[int64]*guest
+	templates     map[string]*template
+	templateOrder []string
+	failures      []*failure
+	windowStart   time.Time
+	windowCount   int
+}
+
+// User is a member of a workspace.
+type User struct {
+	ID       int64
+	Username string
+	Email    string
+	Color    string
+	Role     clickup.Role
+}
+
+// Error is an API error returned by the fake server.
+type Error struct {
+	Status  int
+	Message string
+	Code    string
+}
+
+type failure struct {
+	method   string
+	endpoint string
+	times    int
+	err      Error
+}
+
+// NewServer starts and returns a new Server with a user owning the token
+// "pk_test". The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		Token:      "pk_test",
+		User:       User{ID: 1, Username: "Test User", Email: "test@example.com", Color: "#7b68ee", Role: clickup.RoleOwner},
+		RateLimit:  100,
+		PageSize:   100,
+		Now:        time.Now,
+		nextID:     90000000,
+		users:      make(map[int64]*User),
+		workspaces: make(map[string]*workspace),
+		spaces:     make(map[string]*space),
+		folders:    make(map[string]*folder),
+		lists:      make(map[string]*list),
+		tasks:      make(map[string]*task),
+		comments:   make(map[string]*comment),
+		fields:     make(map[string]*field),
+		webhooks:   make(map[string]*webhook),
+		entries:    make(map[string]*entry),
+		groups:     make(map[string]*group),
+		guests:     make(map[int64]*guest),
+		templates:  make(map[string]*template),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// BaseURL returns the base URL of the API, ending in "/api/v2/".
+func (s *Server) BaseURL() *url.URL {
+	u, _ := url.Parse(s.Server.URL + basePath)
+	return u
+}
+
+// Client returns a clickup.Client authenticated with Token and talking to
+// the server.
+func (s *Server) Client() *clickup.Client {
+	tp := &clickup.PersonalTokenTransport{
+		PersonalToken: s.Token,
+		Transport:     s.Server.Client().Transport,
+	}
+	c := clickup.NewClient(tp.Client())
+	c.BaseURL = s.BaseURL()
+	return c
+}
+
+// Fail makes the next times requests to endpoint fail with err. endpoint is
+// the path relative to the base URL with IDs replaced by {id}, for example
+// "task/{id}/comment". A negative times fails all requests until Reset.
+func (s *Server) Fail(method, endpoint string, times int, err Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, &failure{method: method, endpoint: endpoint, times: times, err: err})
+}
+
+// Reset removes the failures added by Fail and resets the rate limit.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = nil
+	s.windowCount = 0
+}
+
+// call is a request being handled.
+type call struct {
+	w      http.ResponseWriter
+	r      *http.Request
+	ids    []string
+	events []event
+}
+
+// decode reads the JSON request body into v.
+func (c *call) decode(v interface{}) *Error {
+	if c.r.Body == nil || c.r.ContentLength == 0 {
+		return nil
+	}
+	if err := json.NewDecoder(c.r.Body).Decode(v); err != nil {
+		return &Error{http.StatusBadRequest, "Invalid JSON: " + err.Error(), "INPUT_001"}
+	}
+	return nil
+}
+
+// query returns the values of key, accepting both key and key[].
+func (c *call) query(key string) []string {
+	q := c.r.URL.Query()
+	return append(q[key], q[key+"[]"]...)
+}
+
+func (c *call) flag(key string) bool {
+	v, _ := strconv.ParseBool(c.r.URL.Query().Get(key))
+	return v
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	c := &call{w: w, r: r}
+	status, body := s.handle(c)
+
+	// Deliver webhooks before responding, so that a test sees them as soon
+	// as the request returns.
+	s.deliver(c.events)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// handle authenticates, rate limits and routes a request, returning the
+// response status and body.
+func (s *Server) handle(c *call) (int, interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.authenticate(c.r); err != nil {
+		return errorBody(err)
+	}
+	if err := s.limit(c.w); err != nil {
+		return errorBody(err)
+	}
+
+	if !strings.HasPrefix(c.r.URL.Path, basePath) {
+		return errorBody(&Error{http.StatusNotFound, "Route not found", "APP_001"})
+	}
+	segments := splitPath(strings.TrimPrefix(c.r.URL.Path, basePath))
+	for _, rt := range routes {
+		ids, ok := rt.match(c.r.Method, segments)
+		if !ok {
+			continue
+		}
+		if err := s.injected(c.r.Method, rt.pattern); err != nil {
+			return errorBody(err)
+		}
+		c.ids = ids
+		v, err := rt.handle(s, c)
+		if err != nil {
+			c.events = nil
+			return errorBody(err)
+		}
+		return http.StatusOK, v
+	}
+	return errorBody(&Error{http.StatusNotFound, "Route not found", "APP_001"})
+}
+
+func (s *Server) authenticate(r *http.Request) *Error {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	switch {
+	case token == "":
+		return &Error{http.StatusUnauthorized, "Authorization header required", "OAUTH_017"}
+	case s.Token != "" && token != s.Token:
+		return &Error{http.StatusUnauthorized, "Token invalid", "OAUTH_025"}
+	}
+	return nil
+}
+
+// limit counts the request against a fixed one minute window and sets the
+// rate limit headers.
+func (s *Server) limit(w http.ResponseWriter) *Error {
+	if s.RateLimit <= 0 {
+		return nil
+	}
+	now := s.Now()
+	if now.Sub(s.windowStart) >= time.Minute {
+		s.windowStart = now
+		s.windowCount = 0
+	}
+	exceeded := s.windowCount >= s.RateLimit
+	if !exceeded {
+		s.windowCount++
+	}
+	h := w.Header()
+	h.Set("X-RateLimit-Limit", strconv.Itoa(s.RateLimit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(s.RateLimit-s.windowCount))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(s.windowStart.Add(time.Minute).Unix(), 10))
+	if exceeded {
+		return &Error{http.StatusTooManyRequests, "Rate limit reached", "APP_002"}
+	}
+	return nil
+}
+
+func (s *Server) injected(method, endpoint string) *Error {
+	for i, f := range s.failures {
+		if f.method != method || f.endpoint != endpoint {
+			continue
+		}
+		if f.times > 0 {
+			f.times--
+			if f.times == 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+		}
+		err := f.err
+		return &err
+	}
+	return nil
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func errorBody(err *Error) (int, interface{}) {
+	return err.Status, map[string]string{"err": err.Message, "ECODE": err.Code}
+}
+
+func notFound(kind string) *Error {
+	return &Error{http.StatusNotFound, kind + " not found", "ITEM_013"}
+}
+
+func badRequest(msg string) *Error {
+	return &Error{http.StatusBadRequest, msg, "INPUT_005"}
+}
+
+// newID returns a new numeric ID, as used for most ClickUp objects.
+func (s *Server) newID() string {
+	s.nextID++
+	return strconv.FormatInt(s.nextID, 10)
+}
+
+// newTaskID returns a new alphanumeric task ID.
+func (s *Server) newTaskID() string {
+	s.nextID++
+	return "86" + strconv.FormatInt(s.nextID, 36)
+}
+
+// newUUID returns a new ID in the UUID format ClickUp uses for custom fields
+// and webhooks.
+func (s *Server) newUUID() string {
+	s.nextID++
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", s.nextID>>32, s.nextID)
+}
+
+// millis formats t as a string of Unix milliseconds, or nil if t is zero.
+func millis(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+// parseMillis parses a time given as Unix milliseconds in a JSON number or
+// string.
+func parseMillis(v interface{}) (time.Time, bool) {
+	var ms int64
+	switch v := v.(type) {
+	case float64:
+		ms = int64(v)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		ms = n
+	default:
+		return time.Time{}, false
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)), true
+}
@@ -0,0 +1,156 @@
+package clickuptest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+func TestTasksPagination(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	srv.PageSize = 2
+
+	team := srv.AddWorkspace("Acme")
+	space := srv.AddSpace(team, "Engineering")
+	list := srv.AddList(space, "", "Backlog")
+	for _, name := range []string{"a", "b", "c"} {
+		srv.AddTask(list, clickuptest.Task{Name: name})
+	}
+	srv.AddTask(list, clickuptest.Task{Name: "done", Status: "complete"})
+
+	ctx := context.Background()
+	client := srv.Client()
+	it := client.Tasks.ListIter(ctx, list, "")
+	var names []string
+	for it.Next() {
+		names = append(names, it.Task().Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(names), 3; got != want {
+		t.Fatalf("listed %v, want %d open tasks", names, want)
+	}
+	if it.Page() != 2 {
+		t.Errorf("read %d pages, want 2", it.Page())
+	}
+
+	tasks, _, err := client.Tasks.List(ctx, list, "?include_closed=true&page=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks.Tasks) != 2 || !tasks.LastPage {
+		t.Errorf("page 1 = %d tasks, last_page %v; want 2, true", len(tasks.Tasks), tasks.LastPage)
+	}
+}
+
+func TestTaskCRUDAndWebhooks(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	team := srv.AddWorkspace("Acme")
+	space := srv.AddSpace(team, "Engineering")
+	folder := srv.AddFolder(space, "Q1")
+	list := srv.AddList(space, folder, "Sprint 1")
+	points := srv.AddCustomField(list, clickuptest.CustomField{Name: "Points", Type: "number"})
+
+	var deliveries []map[string]interface{}
+	var secret string
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if got := r.Header.Get("X-Signature"); got != clickuptest.Sign(secret, body) {
+			t.Errorf("bad signature %q", got)
+		}
+		var p map[string]interface{}
+		json.Unmarshal(body, &p)
+		deliveries = append(deliveries, p)
+	}))
+	defer hook.Close()
+
+	ctx := context.Background()
+	client := srv.Client()
+	var created struct {
+		Webhook clickup.Webhook `json:"webhook"`
+	}
+	do(t, client, "POST", "team/"+team+"/webhook", map[string]interface{}{
+		"endpoint": hook.URL,
+		"events":   []string{"taskCreated", "taskStatusUpdated"},
+		"list_id":  list,
+	}, &created)
+	secret = created.Webhook.Secret
+
+	var task clickup.Task
+	do(t, client, "POST", "list/"+list+"/task", map[string]interface{}{
+		"name":          "Write tests",
+		"priority":      2,
+		"custom_fields": []map[string]interface{}{{"id": points, "value": 3}},
+	}, &task)
+	do(t, client, "PUT", "task/"+task.ID, map[string]interface{}{"status": "In Progress"}, &task)
+
+	got, _, err := client.Tasks.Get(ctx, task.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Status != "in progress" || got.Folder.ID != folder {
+		t.Errorf("task status %q folder %q, want in progress, %s", got.Status.Status, got.Folder.ID, folder)
+	}
+	if len(got.CustomFields) != 1 || got.CustomFields[0].Value != 3.0 {
+		t.Errorf("custom fields = %+v", got.CustomFields)
+	}
+
+	if len(deliveries) != 2 || deliveries[0]["event"] != "taskCreated" || deliveries[1]["event"] != "taskStatusUpdated" {
+		t.Errorf("deliveries = %v", deliveries)
+	}
+
+	do(t, client, "DELETE", "task/"+task.ID, nil, nil)
+	_, _, err = client.Tasks.Get(ctx, task.ID, "")
+	var errResp *clickup.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Get deleted task: %v, want 404", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	srv.RateLimit = 2
+	srv.AddWorkspace("Acme")
+
+	ctx := context.Background()
+	client := srv.Client()
+	srv.Fail("GET", "team", 1, clickuptest.Error{Status: http.StatusInternalServerError, Message: "boom", Code: "APP_500"})
+	if _, resp, err := client.Workspaces.Get(ctx); err == nil || resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("injected failure: %v", err)
+	}
+	if _, _, err := client.Workspaces.Get(ctx); err != nil {
+		t.Errorf("after injected failure: %v", err)
+	}
+	_, resp, err := client.Workspaces.Get(ctx)
+	if err == nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("over rate limit: %v", err)
+	}
+
+	srv.Reset()
+	srv.Token = "pk_other"
+	if _, resp, _ := client.Workspaces.Get(ctx); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("wrong token: status %d, want 401", resp.StatusCode)
+	}
+}
+
+func do(t *testing.T, c *clickup.Client, method, path string, body, v interface{}) {
+	t.Helper()
+	req, err := c.NewRequest(method, path, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Do(context.Background(), req, v); err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+}
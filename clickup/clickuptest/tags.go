@@ -0,0 +1,180 @@
+package clickuptest
+
+import (
+	"net/http"
+	"strings"
+)
+
+// tagRequest is the body of the space tag endpoints. Edit Space Tag names the
+// colors fg_color and bg_color, Create Space Tag tag_fg and tag_bg, and each
+// ignores the other names, as ClickUp does.
+type tagRequest struct {
+	Tag struct {
+		Name    string  `json:"name"`
+		TagFg   *string `json:"tag_fg"`
+		TagBg   *string `json:"tag_bg"`
+		FgColor *string `json:"fg_color"`
+		BgColor *string `json:"bg_color"`
+	} `json:"tag"`
+}
+
+// spaceTag returns the tag of the space named name, ignoring case, or nil.
+func (s *Server) spaceTag(spaceID, name string) *tag {
+	if sp := s.spaces[spaceID]; sp != nil {
+		for _, tg := range sp.tags {
+			if strings.EqualFold(tg.name, name) {
+				return tg
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) getSpaceTags(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]object, len(sp.tags))
+	for i, tg := range sp.tags {
+		tags[i] = renderTag(tg)
+	}
+	return object{"tags": tags}, nil
+}
+
+func (s *Server) createSpaceTag(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req tagRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Tag.Name == "" {
+		return nil, badRequest("Tag name invalid")
+	}
+	if s.spaceTag(sp.id, req.Tag.Name) != nil {
+		return nil, badRequest("Tag already exists")
+	}
+	tg := &tag{name: req.Tag.Name, fg: "#000000", bg: "#d3d3d3", creator: s.User.ID}
+	if req.Tag.TagFg != nil {
+		tg.fg = *req.Tag.TagFg
+	}
+	if req.Tag.TagBg != nil {
+		tg.bg = *req.Tag.TagBg
+	}
+	sp.tags = append(sp.tags, tg)
+	return object{}, nil
+}
+
+func (s *Server) editSpaceTag(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	tg := s.spaceTag(sp.id, c.ids[1])
+	if tg == nil {
+		return nil, notFound("Tag")
+	}
+	var req tagRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Tag.Name != "" && req.Tag.Name != tg.name {
+		if other := s.spaceTag(sp.id, req.Tag.Name); other != nil && other != tg {
+			return nil, badRequest("Tag already exists")
+		}
+		s.renameTaskTags(sp, tg.name, req.Tag.Name)
+		tg.name = req.Tag.Name
+	}
+	if req.Tag.FgColor != nil {
+		tg.fg = *req.Tag.FgColor
+	}
+	if req.Tag.BgColor != nil {
+		tg.bg = *req.Tag.BgColor
+	}
+	return object{"tag": renderTag(tg)}, nil
+}
+
+func (s *Server) deleteSpaceTag(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	tg := s.spaceTag(sp.id, c.ids[1])
+	if tg == nil {
+		return nil, notFound("Tag")
+	}
+	s.renameTaskTags(sp, tg.name, "")
+	for i, v := range sp.tags {
+		if v == tg {
+			sp.tags = append(sp.tags[:i], sp.tags[i+1:]...)
+			break
+		}
+	}
+	return object{}, nil
+}
+
+// renameTaskTags renames the tag old of the tasks of the space to name, or
+// removes it if name is empty.
+func (s *Server) renameTaskTags(sp *space, old, name string) {
+	for _, t := range s.tasks {
+		if l := s.lists[t.listID]; l == nil || l.spaceID != sp.id {
+			continue
+		}
+		tags := t.tags[:0]
+		for _, v := range t.tags {
+			switch {
+			case !strings.EqualFold(v, old):
+				tags = append(tags, v)
+			case name != "":
+				tags = append(tags, name)
+			}
+		}
+		t.tags = tags
+	}
+}
+
+// addTaskTag adds a tag to a task, creating it in the space of the task if
+// it does not exist.
+func (s *Server) addTaskTag(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	name := c.ids[1]
+	spaceID := s.lists[t.listID].spaceID
+	tg := s.spaceTag(spaceID, name)
+	if tg == nil {
+		tg = &tag{name: name, fg: "#000000", bg: "#d3d3d3", creator: s.User.ID}
+		sp := s.spaces[spaceID]
+		sp.tags = append(sp.tags, tg)
+	}
+	if !containsFold(t.tags, name) {
+		t.tags = append(t.tags, tg.name)
+		t.updated = s.Now()
+		c.emit(s.taskEvent("taskTagUpdated", t))
+	}
+	return object{}, nil
+}
+
+func (s *Server) removeTaskTag(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	if !containsFold(t.tags, c.ids[1]) {
+		return nil, &Error{http.StatusBadRequest, "Tag not found on task", "TAGS_003"}
+	}
+	tags := t.tags[:0]
+	for _, v := range t.tags {
+		if !strings.EqualFold(v, c.ids[1]) {
+			tags = append(tags, v)
+		}
+	}
+	t.tags = tags
+	t.updated = s.Now()
+	c.emit(s.taskEvent("taskTagUpdated", t))
+	return object{}, nil
+}
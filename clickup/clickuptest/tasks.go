@@ -0,0 +1,757 @@
+package clickuptest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (s *Server) task(id string) (*task, *Error) {
+	if t, ok := s.tasks[id]; ok {
+		return t, nil
+	}
+	return nil, notFound("Task")
+}
+
+// taskRequest is the body of the task endpoints. Fields that may be cleared
+// with null are kept raw.
+type taskRequest struct {
+	Name         *string         `json:"name"`
+	Description  *string         `json:"description"`
+	Status       *string         `json:"status"`
+	Priority     json.RawMessage `json:"priority"`
+	Parent       *string         `json:"parent"`
+	DueDate      json.RawMessage `json:"due_date"`
+	StartDate    json.RawMessage `json:"start_date"`
+	TimeEstimate json.RawMessage `json:"time_estimate"`
+	Archived     *bool           `json:"archived"`
+	Tags         []string        `json:"tags"`
+	// Assignees is a list of user IDs on create and an object with add and
+	// rem lists on update.
+	Assignees    json.RawMessage `json:"assignees"`
+	CustomFields []struct {
+		ID    string      `json:"id"`
+		Value interface{} `json:"value"`
+	} `json:"custom_fields"`
+}
+
+func (s *Server) getTask(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.renderTask(t), nil
+}
+
+func (s *Server) createTaskHandler(c *call) (interface{}, *Error) {
+	l, err := s.list(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req taskRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Name == nil || *req.Name == "" {
+		return nil, badRequest("Task name invalid")
+	}
+	if req.Parent != nil && *req.Parent != "" {
+		if _, ok := s.tasks[*req.Parent]; !ok {
+			return nil, notFound("Parent task")
+		}
+	}
+
+	// Validate into a scratch task so that a bad request leaves no task
+	// behind.
+	t := &task{listID: l.id, values: make(map[string]interface{})}
+	if len(req.Assignees) > 0 {
+		if err := json.Unmarshal(req.Assignees, &t.assignees); err != nil {
+			return nil, badRequest("Assignees invalid")
+		}
+	}
+	t.tags = req.Tags
+	if _, err := s.applyTask(t, &req); err != nil {
+		return nil, err
+	}
+	for _, cf := range req.CustomFields {
+		if err := s.setValue(t, cf.ID, cf.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	created := s.createTask(l, s.User.ID)
+	t.id, t.creator, t.created, t.updated = created.id, created.creator, created.created, created.updated
+	if t.status == "" {
+		t.status, t.history = created.status, created.history
+	}
+	*created = *t
+	c.emit(s.taskEvent("taskCreated", created))
+	return s.renderTask(created), nil
+}
+
+func (s *Server) updateTask(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req taskRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+
+	updated := *t
+	updated.assignees = append([]int64(nil), t.assignees...)
+	if len(req.Assignees) > 0 {
+		var assignees struct {
+			Add []int64 `json:"add"`
+			Rem []int64 `json:"rem"`
+		}
+		if err := json.Unmarshal(req.Assignees, &assignees); err != nil {
+			return nil, badRequest("Assignees invalid")
+		}
+		for _, id := range assignees.Rem {
+			updated.assignees = removeInt(updated.assignees, id)
+		}
+		for _, id := range assignees.Add {
+			updated.assignees = append(removeInt(updated.assignees, id), id)
+		}
+	}
+	changes, aerr := s.applyTask(&updated, &req)
+	if aerr != nil {
+		return nil, aerr
+	}
+	if !equalInts(t.assignees, updated.assignees) {
+		changes = append(changes, change{"assignee", "taskAssigneeUpdated", t.assignees, updated.assignees})
+	}
+
+	if len(changes) > 0 {
+		updated.updated = s.Now()
+	}
+	*t = updated
+	s.emitChanges(c, t, changes)
+	return s.renderTask(t), nil
+}
+
+// change is a field changed by a task update, reported in the history items
+// of webhook events.
+type change struct {
+	field  string
+	event  string
+	before interface{}
+	after  interface{}
+}
+
+// applyTask applies the scalar fields of req to t and returns what changed.
+func (s *Server) applyTask(t *task, req *taskRequest) ([]change, *Error) {
+	var changes []change
+	if req.Name != nil && *req.Name != t.name {
+		if *req.Name == "" {
+			return nil, badRequest("Task name invalid")
+		}
+		changes = append(changes, change{"name", "", t.name, *req.Name})
+		t.name = *req.Name
+	}
+	if req.Description != nil && *req.Description != t.description {
+		changes = append(changes, change{"content", "", t.description, *req.Description})
+		t.description = *req.Description
+	}
+	if req.Status != nil && !strings.EqualFold(*req.Status, t.status) {
+		before := t.status
+		if !s.setStatus(t, *req.Status) {
+			return nil, &Error{http.StatusBadRequest, "Status does not exist", "CRTSK_001"}
+		}
+		changes = append(changes, change{"status", "taskStatusUpdated", before, t.status})
+	}
+	if len(req.Priority) > 0 {
+		var p *int
+		if err := json.Unmarshal(req.Priority, &p); err != nil || p != nil && (*p < 1 || *p > 4) {
+			return nil, badRequest("Priority invalid")
+		}
+		priority := 0
+		if p != nil {
+			priority = *p
+		}
+		if priority != t.priority {
+			changes = append(changes, change{"priority", "taskPriorityUpdated", priorities[t.priority], priorities[priority]})
+			t.priority = priority
+		}
+	}
+	if req.Parent != nil && *req.Parent != t.parent {
+		changes = append(changes, change{"parent", "", t.parent, *req.Parent})
+		t.parent = *req.Parent
+	}
+	if req.Archived != nil && *req.Archived != t.archived {
+		changes = append(changes, change{"archived", "", t.archived, *req.Archived})
+		t.archived = *req.Archived
+	}
+	for _, d := range []struct {
+		raw   json.RawMessage
+		field string
+		event string
+		dst   *time.Time
+	}{
+		{req.DueDate, "due_date", "taskDueDateUpdated", &t.dueDate},
+		{req.StartDate, "start_date", "", &t.startDate},
+	} {
+		if len(d.raw) == 0 {
+			continue
+		}
+		v, ok := parseTime(d.raw)
+		if !ok {
+			return nil, badRequest(strings.Replace(d.field, "_", " ", 1) + " invalid")
+		}
+		if !v.Equal(*d.dst) {
+			changes = append(changes, change{d.field, d.event, millis(*d.dst), millis(v)})
+			*d.dst = v
+		}
+	}
+	if len(req.TimeEstimate) > 0 {
+		var ms *int64
+		if err := json.Unmarshal(req.TimeEstimate, &ms); err != nil {
+			return nil, badRequest("Time estimate invalid")
+		}
+		estimate := time.Duration(0)
+		if ms != nil {
+			estimate = time.Duration(*ms) * time.Millisecond
+		}
+		if estimate != t.timeEstimate {
+			changes = append(changes, change{"time_estimate", "taskTimeEstimateUpdated", t.timeEstimate.Milliseconds(), estimate.Milliseconds()})
+			t.timeEstimate = estimate
+		}
+	}
+	return changes, nil
+}
+
+// parseTime parses a JSON time in Unix milliseconds, where null is the zero
+// time.
+func parseTime(raw json.RawMessage) (time.Time, bool) {
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return time.Time{}, false
+	}
+	if v == nil {
+		return time.Time{}, true
+	}
+	return parseMillis(v)
+}
+
+func (s *Server) emitChanges(c *call, t *task, changes []change) {
+	if len(changes) == 0 {
+		return
+	}
+	items := make([]object, len(changes))
+	for i, ch := range changes {
+		items[i] = s.historyItem(ch.field, ch.before, ch.after)
+	}
+	c.emit(s.taskEvent("taskUpdated", t, items...))
+	for i, ch := range changes {
+		if ch.event != "" {
+			c.emit(s.taskEvent(ch.event, t, items[i]))
+		}
+	}
+}
+
+func (s *Server) deleteTaskHandler(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	c.emit(s.taskEvent("taskDeleted", t))
+	s.deleteTask(t)
+	return object{}, nil
+}
+
+func (s *Server) getTasks(c *call) (interface{}, *Error) {
+	l, err := s.list(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.findTasks(c, func(t *task) bool { return t.listID == l.id })
+}
+
+func (s *Server) getTeamTasks(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	lists := set(c.query("list_ids"))
+	spaces := set(c.query("space_ids"))
+	folders := set(c.query("project_ids"))
+	return s.findTasks(c, func(t *task) bool {
+		l := s.lists[t.listID]
+		if sp := s.spaces[l.spaceID]; sp == nil || sp.teamID != ws.id {
+			return false
+		}
+		return (lists == nil || lists[l.id]) &&
+			(spaces == nil || spaces[l.spaceID]) &&
+			(folders == nil || folders[l.folderID])
+	})
+}
+
+// findTasks returns a page of the tasks matching keep and the filters of the
+// query. Tasks are ordered by creation, oldest first, unless order_by or
+// reverse say otherwise.
+func (s *Server) findTasks(c *call, keep func(*task) bool) (interface{}, *Error) {
+	q := c.r.URL.Query()
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page < 0 {
+		return nil, badRequest("Page invalid")
+	}
+	archived := c.flag("archived")
+	includeClosed := c.flag("include_closed")
+	subtasks := c.flag("subtasks")
+	statuses := c.query("statuses")
+	assignees := set(c.query("assignees"))
+	tags := set(c.query("tags"))
+
+	var bounds []func(*task) bool
+	for _, b := range []struct {
+		key string
+		get func(*task) time.Time
+	}{
+		{"date_created", func(t *task) time.Time { return t.created }},
+		{"date_updated", func(t *task) time.Time { return t.updated }},
+		{"date_done", func(t *task) time.Time { return t.closed }},
+		{"due_date", func(t *task) time.Time { return t.dueDate }},
+	} {
+		get := b.get
+		if v, ok := parseMillis(q.Get(b.key + "_gt")); ok {
+			bounds = append(bounds, func(t *task) bool { return get(t).After(v) })
+		}
+		if v, ok := parseMillis(q.Get(b.key + "_lt")); ok {
+			bounds = append(bounds, func(t *task) bool { return !get(t).IsZero() && get(t).Before(v) })
+		}
+	}
+
+	var found []*task
+	for _, id := range s.taskOrder {
+		t := s.tasks[id]
+		if !keep(t) || t.archived != archived || (!subtasks && t.parent != "") {
+			continue
+		}
+		if st, _, _ := s.statusOf(t, t.status); st.Type == "closed" && !includeClosed && len(statuses) == 0 {
+			continue
+		}
+		if len(statuses) > 0 && !containsFold(statuses, t.status) {
+			continue
+		}
+		if assignees != nil && !anyInt(t.assignees, assignees) {
+			continue
+		}
+		if tags != nil && !anyString(t.tags, tags) {
+			continue
+		}
+		ok := true
+		for _, in := range bounds {
+			ok = ok && in(t)
+		}
+		if ok {
+			found = append(found, t)
+		}
+	}
+
+	var less func(a, b *task) bool
+	switch q.Get("order_by") {
+	case "", "created":
+	case "updated":
+		less = func(a, b *task) bool { return a.updated.Before(b.updated) }
+	case "id":
+		less = func(a, b *task) bool { return a.id < b.id }
+	case "due_date":
+		less = func(a, b *task) bool {
+			return !a.dueDate.IsZero() && (b.dueDate.IsZero() || a.dueDate.Before(b.dueDate))
+		}
+	default:
+		return nil, badRequest("Order by invalid")
+	}
+	if less != nil {
+		sort.SliceStable(found, func(i, j int) bool { return less(found[i], found[j]) })
+	}
+	if c.flag("reverse") {
+		for i, j := 0, len(found)-1; i < j; i, j = i+1, j-1 {
+			found[i], found[j] = found[j], found[i]
+		}
+	}
+
+	start, end := s.pageBounds(len(found), page)
+	tasks := make([]object, 0, end-start)
+	for _, t := range found[start:end] {
+		tasks = append(tasks, s.renderTask(t))
+	}
+	return object{"tasks": tasks, "last_page": end == len(found)}, nil
+}
+
+// pageBounds returns the bounds of page in a result of n items, paged by
+// PageSize.
+func (s *Server) pageBounds(n, page int) (start, end int) {
+	size := s.PageSize
+	if size <= 0 {
+		size = 100
+	}
+	start, end = page*size, (page+1)*size
+	if start > n {
+		start = n
+	}
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+func (s *Server) getTimeInStatus(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.renderTimeInStatus(t), nil
+}
+
+// getBulkTimeInStatus returns the time in status of the tasks in task_ids,
+// keyed by task ID. Like ClickUp it accepts at most 100 tasks.
+func (s *Server) getBulkTimeInStatus(c *call) (interface{}, *Error) {
+	ids := c.query("task_ids")
+	if len(ids) == 0 || len(ids) > 100 {
+		return nil, badRequest("Task IDs invalid")
+	}
+	result := object{}
+	for _, id := range ids {
+		t, err := s.task(id)
+		if err != nil {
+			return nil, err
+		}
+		result[id] = s.renderTimeInStatus(t)
+	}
+	return result, nil
+}
+
+// renderTimeInStatus sums the time t spent in each status it entered, in
+// whole minutes as ClickUp reports it. The history lists every status once,
+// in the order they were first entered and with that time as since; the
+// current status counts from when t last entered it.
+func (s *Server) renderTimeInStatus(t *task) object {
+	now := s.Now()
+	var order []string
+	totals := make(map[string]time.Duration)
+	first := make(map[string]time.Time)
+	for i, h := range t.history {
+		end := now
+		if i+1 < len(t.history) {
+			end = t.history[i+1].at
+		}
+		if _, ok := first[h.status]; !ok {
+			order = append(order, h.status)
+			first[h.status] = h.at
+		}
+		totals[h.status] += end.Sub(h.at)
+	}
+	history := make([]object, 0, len(order))
+	for _, name := range order {
+		history = append(history, s.renderStatusTime(t, name, totals[name], first[name]))
+	}
+	var current time.Duration
+	var since time.Time
+	if n := len(t.history); n > 0 {
+		since = t.history[n-1].at
+		current = now.Sub(since)
+	}
+	return object{
+		"current_status": s.renderStatusTime(t, t.status, current, since),
+		"status_history": history,
+	}
+}
+
+func (s *Server) renderStatusTime(t *task, name string, total time.Duration, since time.Time) object {
+	st, i, _ := s.statusOf(t, name)
+	return object{
+		"status":     name,
+		"color":      st.Color,
+		"type":       st.Type,
+		"orderindex": i,
+		"total_time": object{"by_minute": int64(total / time.Minute), "since": millis(since)},
+	}
+}
+
+// commentRequest is the body of the comment endpoints.
+type commentRequest struct {
+	CommentText *string `json:"comment_text"`
+	Assignee    *int64  `json:"assignee"`
+	Resolved    *bool   `json:"resolved"`
+}
+
+func (s *Server) getTaskComments(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.renderComments(func(cm *comment) bool { return cm.taskID == t.id }), nil
+}
+
+func (s *Server) getListComments(c *call) (interface{}, *Error) {
+	l, err := s.list(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.renderComments(func(cm *comment) bool { return cm.listID == l.id }), nil
+}
+
+// renderComments returns the comments matching keep, newest first like
+// ClickUp.
+func (s *Server) renderComments(keep func(*comment) bool) object {
+	var found []*comment
+	for _, cm := range s.comments {
+		if keep(cm) {
+			found = append(found, cm)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if !found[i].date.Equal(found[j].date) {
+			return found[i].date.After(found[j].date)
+		}
+		return found[i].id > found[j].id
+	})
+	comments := make([]object, len(found))
+	for i, cm := range found {
+		comments[i] = s.renderComment(cm)
+	}
+	return object{"comments": comments}
+}
+
+func (s *Server) createTaskComment(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.createCommentHandler(c, &comment{taskID: t.id}, t)
+}
+
+func (s *Server) createListComment(c *call) (interface{}, *Error) {
+	l, err := s.list(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.createCommentHandler(c, &comment{listID: l.id}, nil)
+}
+
+func (s *Server) createCommentHandler(c *call, cm *comment, t *task) (interface{}, *Error) {
+	var req commentRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.CommentText == nil || *req.CommentText == "" {
+		return nil, badRequest("Comment text invalid")
+	}
+	cm.id = s.newID()
+	cm.text = *req.CommentText
+	cm.user = s.User.ID
+	cm.date = s.Now()
+	if req.Assignee != nil {
+		cm.assignee = *req.Assignee
+	}
+	s.comments[cm.id] = cm
+	if t != nil {
+		c.emit(s.taskEvent("taskCommentPosted", t, s.historyItem("comment", nil, s.renderComment(cm))))
+	}
+	id, _ := strconv.ParseInt(cm.id, 10, 64)
+	return object{"id": id, "hist_id": cm.id, "date": cm.date.UnixNano() / int64(time.Millisecond)}, nil
+}
+
+func (s *Server) updateComment(c *call) (interface{}, *Error) {
+	cm, ok := s.comments[c.ids[0]]
+	if !ok {
+		return nil, notFound("Comment")
+	}
+	var req commentRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	before := s.renderComment(cm)
+	if req.CommentText != nil {
+		cm.text = *req.CommentText
+	}
+	if req.Assignee != nil {
+		cm.assignee = *req.Assignee
+	}
+	if req.Resolved != nil {
+		cm.resolved = *req.Resolved
+	}
+	if t := s.tasks[cm.taskID]; t != nil {
+		c.emit(s.taskEvent("taskCommentUpdated", t, s.historyItem("comment", before, s.renderComment(cm))))
+	}
+	return object{}, nil
+}
+
+func (s *Server) deleteComment(c *call) (interface{}, *Error) {
+	if _, ok := s.comments[c.ids[0]]; !ok {
+		return nil, notFound("Comment")
+	}
+	delete(s.comments, c.ids[0])
+	return object{}, nil
+}
+
+func (s *Server) getFields(c *call) (interface{}, *Error) {
+	l, err := s.list(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]object, len(l.fields))
+	for i, id := range l.fields {
+		fields[i] = s.renderField(s.fields[id])
+	}
+	return object{"fields": fields}, nil
+}
+
+func (s *Server) setFieldValue(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req struct {
+		Value interface{} `json:"value"`
+	}
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	before := t.values[c.ids[1]]
+	if err := s.setValue(t, c.ids[1], req.Value); err != nil {
+		return nil, err
+	}
+	t.updated = s.Now()
+	s.emitChanges(c, t, []change{{"custom_field", "", before, req.Value}})
+	return object{}, nil
+}
+
+func (s *Server) removeFieldValue(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.taskField(t, c.ids[1]); err != nil {
+		return nil, err
+	}
+	if before, ok := t.values[c.ids[1]]; ok {
+		delete(t.values, c.ids[1])
+		t.updated = s.Now()
+		s.emitChanges(c, t, []change{{"custom_field", "", before, nil}})
+	}
+	return object{}, nil
+}
+
+// taskField returns the custom field id of the list of t.
+func (s *Server) taskField(t *task, id string) (*field, *Error) {
+	f, ok := s.fields[id]
+	if !ok || f.listID != t.listID {
+		return nil, &Error{http.StatusBadRequest, "Custom field not found", "FIELD_002"}
+	}
+	return f, nil
+}
+
+// setValue validates v for the custom field id and sets it on t.
+func (s *Server) setValue(t *task, id string, v interface{}) *Error {
+	f, err := s.taskField(t, id)
+	if err != nil {
+		return err
+	}
+	valid := true
+	switch f.typ {
+	case "number", "currency", "emoji":
+		switch n := v.(type) {
+		case float64:
+		case string:
+			_, perr := strconv.ParseFloat(n, 64)
+			valid = perr == nil
+		default:
+			valid = false
+		}
+	case "checkbox":
+		_, valid = v.(bool)
+	case "date":
+		_, valid = parseMillis(v)
+	}
+	if !valid || v == nil {
+		return &Error{http.StatusBadRequest, "Value is not valid for custom field " + f.name, "FIELD_010"}
+	}
+	t.values[id] = v
+	return nil
+}
+
+func set(values []string) map[string]bool {
+	if len(values) == 0 {
+		return nil
+	}
+	m := make(map[string]bool, len(values))
+	for _, v := range values {
+		m[v] = true
+	}
+	return m
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(values []int64, v int64) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func anyString(values []string, in map[string]bool) bool {
+	for _, v := range values {
+		if in[v] {
+			return true
+		}
+	}
+	return false
+}
+
+func anyInt(values []int64, in map[string]bool) bool {
+	for _, v := range values {
+		if in[strconv.FormatInt(v, 10)] {
+			return true
+		}
+	}
+	return false
+}
+
+func removeInt(values []int64, v int64) []int64 {
+	out := values[:0]
+	for _, x := range values {
+		if x != v {
+			out = append(out, x)
+		}
+	}
+	return out
+}
+
+func removeFold(values []string, s string) []string {
+	out := values[:0]
+	for _, v := range values {
+		if !strings.EqualFold(v, s) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func equalInts(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+package clickuptest
+
+import "strconv"
+
+// templateRequest is the body of the endpoints creating from a template.
+type templateRequest struct {
+	Name string `json:"name"`
+}
+
+// template returns the template id of kind, which must belong to the
+// workspace teamID.
+func (s *Server) template(id, kind, teamID string) (*template, *Error) {
+	if tp, ok := s.templates[id]; ok && tp.kind == kind && tp.teamID == teamID {
+		return tp, nil
+	}
+	return nil, notFound("Template")
+}
+
+// templateName decodes the name of the object to create from a template.
+func templateName(c *call) (string, *Error) {
+	var req templateRequest
+	if err := c.decode(&req); err != nil {
+		return "", err
+	}
+	if req.Name == "" {
+		return "", badRequest("Name invalid")
+	}
+	return req.Name, nil
+}
+
+// getTaskTemplates lists a page of the task templates of a workspace. Like
+// ClickUp it requires the page parameter.
+func (s *Server) getTaskTemplates(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	q := c.r.URL.Query()
+	if q["page"] == nil {
+		return nil, badRequest("Page required")
+	}
+	page, perr := strconv.Atoi(q.Get("page"))
+	if perr != nil || page < 0 {
+		return nil, badRequest("Page invalid")
+	}
+	var found []*template
+	for _, id := range s.templateOrder {
+		if tp := s.templates[id]; tp.kind == "task" && tp.teamID == ws.id {
+			found = append(found, tp)
+		}
+	}
+	start, end := s.pageBounds(len(found), page)
+	templates := make([]object, 0, end-start)
+	for _, tp := range found[start:end] {
+		templates = append(templates, object{"id": tp.id, "name": tp.name})
+	}
+	return object{"templates": templates}, nil
+}
+
+func (s *Server) createTaskFromTemplate(c *call) (interface{}, *Error) {
+	l, err := s.list(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	tp, err := s.template(c.ids[1], "task", s.spaces[l.spaceID].teamID)
+	if err != nil {
+		return nil, err
+	}
+	name, err := templateName(c)
+	if err != nil {
+		return nil, err
+	}
+	t := tp.task
+	t.Name = name
+	created := s.seedTask(l, t)
+	c.emit(s.taskEvent("taskCreated", created))
+	return object{"id": created.id, "task": s.renderTask(created)}, nil
+}
+
+func (s *Server) createFolderListFromTemplate(c *call) (interface{}, *Error) {
+	f, err := s.folder(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.createListFromTemplate(c, f.spaceID, f.id)
+}
+
+func (s *Server) createFolderlessListFromTemplate(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.createListFromTemplate(c, sp.id, "")
+}
+
+func (s *Server) createListFromTemplate(c *call, spaceID, folderID string) (interface{}, *Error) {
+	tp, err := s.template(c.ids[1], "list", s.spaces[spaceID].teamID)
+	if err != nil {
+		return nil, err
+	}
+	name, err := templateName(c)
+	if err != nil {
+		return nil, err
+	}
+	l := s.createList(spaceID, folderID, name)
+	for _, t := range tp.tasks {
+		s.seedTask(l, t)
+	}
+	c.emit(s.listEvent("listCreated", l))
+	return object{"id": l.id, "list": s.renderList(l)}, nil
+}
+
+func (s *Server) createFolderFromTemplate(c *call) (interface{}, *Error) {
+	sp, err := s.space(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	tp, err := s.template(c.ids[1], "folder", sp.teamID)
+	if err != nil {
+		return nil, err
+	}
+	name, err := templateName(c)
+	if err != nil {
+		return nil, err
+	}
+	f := s.createFolder(sp.id, name)
+	for _, list := range tp.lists {
+		s.createList(sp.id, f.id, list)
+	}
+	c.emit(s.folderEvent("folderCreated", f))
+	return object{"id": f.id, "folder": s.renderFolder(f)}, nil
+}
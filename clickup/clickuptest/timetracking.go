@@ -0,0 +1,651 @@
+package clickuptest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// trackRequest is the body of the legacy time tracking endpoints.
+type trackRequest struct {
+	Start json.RawMessage `json:"start"`
+	End   json.RawMessage `json:"end"`
+	Time  *int64          `json:"time"`
+}
+
+// entryRequest is the body of the time entry endpoints. Start and End are
+// kept raw like the dates of taskRequest.
+type entryRequest struct {
+	Description *string         `json:"description"`
+	Tags        []entryTag      `json:"tags"`
+	TagAction   string          `json:"tag_action"`
+	Start       json.RawMessage `json:"start"`
+	End         json.RawMessage `json:"end"`
+	Duration    *int64          `json:"duration"`
+	Billable    *bool           `json:"billable"`
+	Assignee    int64           `json:"assignee"`
+	TaskID      string          `json:"tid"`
+}
+
+type entryTag struct {
+	Name  string `json:"name"`
+	TagFg string `json:"tag_fg"`
+	TagBg string `json:"tag_bg"`
+}
+
+// entryTagsRequest is the body of the endpoints changing time entry tags.
+type entryTagsRequest struct {
+	TimeEntryIDs []string   `json:"time_entry_ids"`
+	Tags         []entryTag `json:"tags"`
+	// Name, NewName, TagBg and TagFg are only sent to rename a tag.
+	Name    string `json:"name"`
+	NewName string `json:"new_name"`
+	TagBg   string `json:"tag_bg"`
+	TagFg   string `json:"tag_fg"`
+}
+
+// teamOf returns the ID of the workspace of a task.
+func (s *Server) teamOf(t *task) string {
+	return s.spaces[s.lists[t.listID].spaceID].teamID
+}
+
+// entry returns the time entry id of the workspace teamID.
+func (s *Server) entry(teamID, id string) (*entry, *Error) {
+	if e, ok := s.entries[id]; ok && e.teamID == teamID {
+		return e, nil
+	}
+	return nil, notFound("Time entry")
+}
+
+// running returns the running time entry of user in the workspace, or nil.
+func (s *Server) running(teamID string, user int64) *entry {
+	for _, id := range s.entryOrder {
+		if e := s.entries[id]; e.teamID == teamID && e.user == user && e.end.IsZero() {
+			return e
+		}
+	}
+	return nil
+}
+
+func (s *Server) addEntry(e *entry) {
+	e.id = s.newID()
+	e.source = "clickup"
+	e.at = s.Now()
+	s.entries[e.id] = e
+	s.entryOrder = append(s.entryOrder, e.id)
+}
+
+// timeSpent returns the time tracked on a task by stopped entries.
+func (s *Server) timeSpent(t *task) time.Duration {
+	var d time.Duration
+	for _, e := range s.entries {
+		if e.taskID == t.id && !e.end.IsZero() {
+			d += e.end.Sub(e.start)
+		}
+	}
+	return d
+}
+
+func (s *Server) getTrackedTime(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var users []int64
+	byUser := make(map[int64][]*entry)
+	for _, id := range s.entryOrder {
+		e := s.entries[id]
+		if e.taskID != t.id || e.end.IsZero() {
+			continue
+		}
+		if _, ok := byUser[e.user]; !ok {
+			users = append(users, e.user)
+		}
+		byUser[e.user] = append(byUser[e.user], e)
+	}
+	data := make([]object, 0, len(users))
+	for _, user := range users {
+		var total time.Duration
+		intervals := make([]object, 0, len(byUser[user]))
+		for _, e := range byUser[user] {
+			total += e.end.Sub(e.start)
+			intervals = append(intervals, object{
+				"id":         e.id,
+				"start":      millis(e.start),
+				"end":        millis(e.end),
+				"time":       strconv.FormatInt(e.end.Sub(e.start).Milliseconds(), 10),
+				"source":     e.source,
+				"date_added": millis(e.at),
+			})
+		}
+		data = append(data, object{"user": s.renderUser(user), "time": total.Milliseconds(), "intervals": intervals})
+	}
+	return object{"data": data}, nil
+}
+
+// interval reads the start and end of a legacy time tracking request, with
+// the end derived from time when it is not given.
+func (req *trackRequest) interval() (time.Time, time.Time, *Error) {
+	start, ok := parseTime(req.Start)
+	if len(req.Start) == 0 || !ok || start.IsZero() {
+		return time.Time{}, time.Time{}, badRequest("Start invalid")
+	}
+	end := start
+	switch {
+	case len(req.End) > 0:
+		if end, ok = parseTime(req.End); !ok {
+			return time.Time{}, time.Time{}, badRequest("End invalid")
+		}
+	case req.Time != nil:
+		end = start.Add(time.Duration(*req.Time) * time.Millisecond)
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, badRequest("Time invalid")
+	}
+	return start, end, nil
+}
+
+func (s *Server) trackTime(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req trackRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	start, end, err := req.interval()
+	if err != nil {
+		return nil, err
+	}
+	e := &entry{teamID: s.teamOf(t), taskID: t.id, user: s.User.ID, start: start, end: end}
+	s.addEntry(e)
+	return object{"id": e.id}, nil
+}
+
+// taskEntry returns the stopped time entry id of a task.
+func (s *Server) taskEntry(t *task, id string) (*entry, *Error) {
+	if e, ok := s.entries[id]; ok && e.taskID == t.id && !e.end.IsZero() {
+		return e, nil
+	}
+	return nil, notFound("Interval")
+}
+
+func (s *Server) editTrackedTime(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.taskEntry(t, c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	var req trackRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	start, end, err := req.interval()
+	if err != nil {
+		return nil, err
+	}
+	e.start, e.end = start, end
+	return object{}, nil
+}
+
+func (s *Server) deleteTrackedTime(c *call) (interface{}, *Error) {
+	t, err := s.task(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.taskEntry(t, c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	s.deleteEntry(e)
+	return object{}, nil
+}
+
+func (s *Server) deleteEntry(e *entry) {
+	s.entryOrder = remove(s.entryOrder, e.id)
+	delete(s.entries, e.id)
+}
+
+// getEntries lists the time entries of a workspace. Like on ClickUp, it
+// defaults to the entries of the authenticated user started in the last 30
+// days.
+func (s *Server) getEntries(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	q := c.r.URL.Query()
+	end := s.Now()
+	if v := q.Get("end_date"); v != "" {
+		t, ok := parseMillis(v)
+		if !ok {
+			return nil, badRequest("End date invalid")
+		}
+		end = t
+	}
+	start := end.AddDate(0, 0, -30)
+	if v := q.Get("start_date"); v != "" {
+		t, ok := parseMillis(v)
+		if !ok {
+			return nil, badRequest("Start date invalid")
+		}
+		start = t
+	}
+	users := map[int64]bool{s.User.ID: true}
+	if v := q.Get("assignee"); v != "" {
+		users = make(map[int64]bool)
+		for _, f := range strings.Split(v, ",") {
+			id, err := strconv.ParseInt(f, 10, 64)
+			if err != nil {
+				return nil, badRequest("Assignee invalid")
+			}
+			users[id] = true
+		}
+	}
+	var location, locationID string
+	for _, key := range []string{"space_id", "folder_id", "list_id", "task_id"} {
+		if v := q.Get(key); v != "" {
+			if location != "" {
+				return nil, badRequest("Only one of space_id, folder_id, list_id and task_id is allowed")
+			}
+			location, locationID = key, v
+		}
+	}
+
+	entries := []object{}
+	for _, id := range s.entryOrder {
+		e := s.entries[id]
+		if e.teamID != ws.id || !users[e.user] || e.start.Before(start) || e.start.After(end) {
+			continue
+		}
+		if location != "" && s.entryLocation(e)[location] != locationID {
+			continue
+		}
+		entries = append(entries, s.renderEntry(c, e))
+	}
+	return object{"data": entries}, nil
+}
+
+// entryLocation returns the IDs of the task, list, folder and space of an
+// entry, keyed by their query parameter.
+func (s *Server) entryLocation(e *entry) map[string]string {
+	t := s.tasks[e.taskID]
+	if t == nil {
+		return nil
+	}
+	l := s.lists[t.listID]
+	return map[string]string{"task_id": t.id, "list_id": l.id, "folder_id": l.folderID, "space_id": l.spaceID}
+}
+
+func (s *Server) getEntry(c *call) (interface{}, *Error) {
+	e, err := s.entry(c.ids[0], c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	return object{"data": s.renderEntry(c, e)}, nil
+}
+
+// getEntryHistory returns no changes, as the fake does not record them.
+func (s *Server) getEntryHistory(c *call) (interface{}, *Error) {
+	if _, err := s.entry(c.ids[0], c.ids[1]); err != nil {
+		return nil, err
+	}
+	return object{"data": []object{}}, nil
+}
+
+func (s *Server) getRunningEntry(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	user := s.User.ID
+	if v := c.r.URL.Query().Get("assignee"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, badRequest("Assignee invalid")
+		}
+		user = id
+	}
+	if e := s.running(ws.id, user); e != nil {
+		return object{"data": s.renderEntry(c, e)}, nil
+	}
+	return object{"data": nil}, nil
+}
+
+// startEntry starts a timer for the authenticated user, stopping the one
+// already running.
+func (s *Server) startEntry(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req entryRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	now := s.Now()
+	if e := s.running(ws.id, s.User.ID); e != nil {
+		e.end = now
+	}
+	e := &entry{teamID: ws.id, user: s.User.ID, start: now}
+	if err := s.applyEntry(ws, e, &req); err != nil {
+		return nil, err
+	}
+	s.addEntry(e)
+	return object{"data": s.renderEntry(c, e)}, nil
+}
+
+func (s *Server) stopEntry(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	e := s.running(ws.id, s.User.ID)
+	if e == nil {
+		return nil, &Error{http.StatusBadRequest, "No time entry running", "TIMEENTRY_005"}
+	}
+	e.end = s.Now()
+	return object{"data": s.renderEntry(c, e)}, nil
+}
+
+func (s *Server) createEntry(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req entryRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if len(req.Start) == 0 {
+		return nil, badRequest("Start invalid")
+	}
+	if len(req.End) == 0 && (req.Duration == nil || *req.Duration <= 0) {
+		return nil, badRequest("Duration invalid")
+	}
+	e := &entry{teamID: ws.id, user: s.User.ID}
+	if req.Assignee != 0 {
+		if !containsInt(ws.members, req.Assignee) {
+			return nil, badRequest("Assignee invalid")
+		}
+		e.user = req.Assignee
+	}
+	if err := s.applyEntry(ws, e, &req); err != nil {
+		return nil, err
+	}
+	s.addEntry(e)
+	return object{"data": s.renderEntry(c, e)}, nil
+}
+
+func (s *Server) updateEntry(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.entry(ws.id, c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	var req entryRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if err := s.applyEntry(ws, e, &req); err != nil {
+		return nil, err
+	}
+	return object{}, nil
+}
+
+func (s *Server) deleteEntryHandler(c *call) (interface{}, *Error) {
+	e, err := s.entry(c.ids[0], c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	s.deleteEntry(e)
+	return object{"data": s.renderEntry(c, e)}, nil
+}
+
+// applyEntry applies the fields set in req to e. The end is set from end or,
+// failing that, from duration; tags are added unless the tag action is
+// "remove".
+func (s *Server) applyEntry(ws *workspace, e *entry, req *entryRequest) *Error {
+	if req.TaskID != "" {
+		t, err := s.task(req.TaskID)
+		if err != nil {
+			return err
+		}
+		if s.teamOf(t) != ws.id {
+			return notFound("Task")
+		}
+		e.taskID = t.id
+	}
+	if req.Description != nil {
+		e.description = *req.Description
+	}
+	if req.Billable != nil {
+		e.billable = *req.Billable
+	}
+	start, end := e.start, e.end
+	if len(req.Start) > 0 {
+		v, ok := parseTime(req.Start)
+		if !ok || v.IsZero() {
+			return badRequest("Start invalid")
+		}
+		start = v
+	}
+	switch {
+	case len(req.End) > 0:
+		v, ok := parseTime(req.End)
+		if !ok {
+			return badRequest("End invalid")
+		}
+		end = v
+	case req.Duration != nil && *req.Duration > 0:
+		end = start.Add(time.Duration(*req.Duration) * time.Millisecond)
+	}
+	if !end.IsZero() && end.Before(start) {
+		return badRequest("End must be after start")
+	}
+	e.start, e.end = start, end
+	for _, tg := range req.Tags {
+		if tg.Name == "" {
+			return badRequest("Tag name invalid")
+		}
+		if req.TagAction == "remove" {
+			e.tags = removeFold(e.tags, tg.Name)
+			continue
+		}
+		if s.entryTag(ws, tg.Name) == nil {
+			ws.entryTags = append(ws.entryTags, newEntryTag(tg, s.User.ID))
+		}
+		if !containsFold(e.tags, tg.Name) {
+			e.tags = append(e.tags, s.entryTag(ws, tg.Name).name)
+		}
+	}
+	return nil
+}
+
+func newEntryTag(tg entryTag, creator int64) *tag {
+	t := &tag{name: tg.Name, fg: tg.TagFg, bg: tg.TagBg, creator: creator}
+	if t.fg == "" {
+		t.fg = "#000000"
+	}
+	if t.bg == "" {
+		t.bg = "#d3d3d3"
+	}
+	return t
+}
+
+// entryTag returns the time entry tag of the workspace named name, ignoring
+// case, or nil.
+func (s *Server) entryTag(ws *workspace, name string) *tag {
+	for _, tg := range ws.entryTags {
+		if strings.EqualFold(tg.name, name) {
+			return tg
+		}
+	}
+	return nil
+}
+
+// getEntryTags lists the time entry tags in use in a workspace.
+func (s *Server) getEntryTags(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]bool)
+	for _, e := range s.entries {
+		if e.teamID == ws.id {
+			for _, name := range e.tags {
+				used[strings.ToLower(name)] = true
+			}
+		}
+	}
+	tags := []object{}
+	for _, tg := range ws.entryTags {
+		if used[strings.ToLower(tg.name)] {
+			tags = append(tags, renderTag(tg))
+		}
+	}
+	return object{"data": tags}, nil
+}
+
+// changeEntryTags adds the tags of the request to its entries, or removes
+// them if remove is set.
+func (s *Server) changeEntryTags(c *call, remove bool) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req entryTagsRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if len(req.TimeEntryIDs) == 0 || len(req.Tags) == 0 {
+		return nil, badRequest("time_entry_ids and tags are required")
+	}
+	entries := make([]*entry, len(req.TimeEntryIDs))
+	for i, id := range req.TimeEntryIDs {
+		if entries[i], err = s.entry(ws.id, id); err != nil {
+			return nil, err
+		}
+	}
+	action := ""
+	if remove {
+		action = "remove"
+	}
+	for _, e := range entries {
+		if err := s.applyEntry(ws, e, &entryRequest{Tags: req.Tags, TagAction: action}); err != nil {
+			return nil, err
+		}
+	}
+	return object{}, nil
+}
+
+func (s *Server) addEntryTags(c *call) (interface{}, *Error) {
+	return s.changeEntryTags(c, false)
+}
+
+func (s *Server) removeEntryTags(c *call) (interface{}, *Error) {
+	return s.changeEntryTags(c, true)
+}
+
+func (s *Server) editEntryTag(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req entryTagsRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	tg := s.entryTag(ws, req.Name)
+	if tg == nil {
+		return nil, notFound("Tag")
+	}
+	if req.NewName != "" && req.NewName != tg.name {
+		if other := s.entryTag(ws, req.NewName); other != nil && other != tg {
+			return nil, badRequest("Tag already exists")
+		}
+		for _, e := range s.entries {
+			if e.teamID != ws.id {
+				continue
+			}
+			for i, name := range e.tags {
+				if strings.EqualFold(name, tg.name) {
+					e.tags[i] = req.NewName
+				}
+			}
+		}
+		tg.name = req.NewName
+	}
+	if req.TagFg != "" {
+		tg.fg = req.TagFg
+	}
+	if req.TagBg != "" {
+		tg.bg = req.TagBg
+	}
+	return object{}, nil
+}
+
+// renderEntry renders a time entry, with the names of its location and the
+// tags of its task when the request asks for them.
+func (s *Server) renderEntry(c *call, e *entry) object {
+	duration := "-" + millis(e.start).(string)
+	if !e.end.IsZero() {
+		duration = strconv.FormatInt(e.end.Sub(e.start).Milliseconds(), 10)
+	}
+	ws := s.workspaces[e.teamID]
+	tags := make([]object, 0, len(e.tags))
+	for _, name := range e.tags {
+		if tg := s.entryTag(ws, name); tg != nil {
+			tags = append(tags, renderTag(tg))
+		}
+	}
+	out := object{
+		"id":          e.id,
+		"task":        nil,
+		"wid":         e.teamID,
+		"user":        s.renderUser(e.user),
+		"billable":    e.billable,
+		"start":       millis(e.start),
+		"end":         millis(e.end),
+		"duration":    duration,
+		"description": e.description,
+		"tags":        tags,
+		"source":      e.source,
+		"at":          millis(e.at),
+	}
+	t := s.tasks[e.taskID]
+	if t == nil {
+		return out
+	}
+	st, index, _ := s.statusOf(t, t.status)
+	out["task"] = object{
+		"id":          t.id,
+		"name":        t.name,
+		"status":      object{"status": st.Status, "color": st.Color, "type": st.Type, "orderindex": index},
+		"custom_type": nil,
+	}
+	out["task_url"] = "https://app.clickup.com/t/" + t.id
+	l := s.lists[t.listID]
+	location := object{"list_id": l.id, "folder_id": l.folderID, "space_id": l.spaceID}
+	if c.flag("include_location_names") {
+		location["list_name"] = l.name
+		location["space_name"] = s.spaces[l.spaceID].name
+		if f := s.folders[l.folderID]; f != nil {
+			location["folder_name"] = f.name
+		}
+	}
+	out["task_location"] = location
+	if c.flag("include_task_tags") {
+		out["task_tags"] = s.renderTaskTags(t)
+	}
+	return out
+}
@@ -0,0 +1,157 @@
+package clickuptest
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// userRequest is the body of Invite User and Edit User.
+type userRequest struct {
+	Email        string `json:"email"`
+	Username     string `json:"username"`
+	Admin        bool   `json:"admin"`
+	CustomRoleID int64  `json:"custom_role_id"`
+}
+
+// lookupUser returns the user id, or nil.
+func (s *Server) lookupUser(id int64) *User {
+	if u, ok := s.users[id]; ok {
+		return u
+	}
+	if id == s.User.ID {
+		return &s.User
+	}
+	return nil
+}
+
+// member returns the member id of the workspace ws.
+func (s *Server) member(ws *workspace, id string) (*User, *Error) {
+	userID, _ := strconv.ParseInt(id, 10, 64)
+	if u := s.lookupUser(userID); u != nil && containsInt(ws.members, userID) {
+		return u, nil
+	}
+	return nil, notFound("User")
+}
+
+// memberByEmail returns the member of ws with the email, ignoring case, or
+// nil.
+func (s *Server) memberByEmail(ws *workspace, email string) *User {
+	for _, id := range ws.members {
+		if u := s.lookupUser(id); u != nil && strings.EqualFold(u.Email, email) {
+			return u
+		}
+	}
+	return nil
+}
+
+func (s *Server) inviteUser(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req userRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if !strings.Contains(req.Email, "@") {
+		return nil, badRequest("Email invalid")
+	}
+	if s.memberByEmail(ws, req.Email) != nil {
+		return nil, badRequest("User is already a member of the workspace")
+	}
+	s.nextID++
+	u := &User{ID: s.nextID, Username: req.Email[:strings.Index(req.Email, "@")], Email: req.Email, Color: "#7b68ee", Role: clickup.RoleMember}
+	if req.Admin {
+		u.Role = clickup.RoleAdmin
+	}
+	s.users[u.ID] = u
+	ws.members = append(ws.members, u.ID)
+	setCustomRole(ws, u.ID, req.CustomRoleID)
+	return object{"team": s.renderWorkspace(ws)}, nil
+}
+
+func (s *Server) getMember(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.member(ws, c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	return object{"member": s.renderMember(ws, u.ID)}, nil
+}
+
+// editMember changes the username, role and custom role of a member. The
+// role of the owner cannot be changed.
+func (s *Server) editMember(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.member(ws, c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	var req userRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Username != "" {
+		u.Username = req.Username
+	}
+	switch {
+	case u.Role == clickup.RoleOwner || u.Role == clickup.RoleGuest:
+	case req.Admin:
+		u.Role = clickup.RoleAdmin
+	default:
+		u.Role = clickup.RoleMember
+	}
+	setCustomRole(ws, u.ID, req.CustomRoleID)
+	return object{"member": s.renderMember(ws, u.ID)}, nil
+}
+
+func (s *Server) removeMember(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.member(ws, c.ids[1])
+	if err != nil {
+		return nil, err
+	}
+	if u.Role == clickup.RoleOwner {
+		return nil, badRequest("Cannot remove the owner of the workspace")
+	}
+	ws.members = removeInt(ws.members, u.ID)
+	delete(ws.customRoles, u.ID)
+	if g, ok := s.guests[u.ID]; ok && g.teamID == ws.id {
+		delete(s.guests, u.ID)
+	}
+	return object{"team": s.renderWorkspace(ws)}, nil
+}
+
+// setCustomRole gives a member a custom role, unless id is zero.
+func setCustomRole(ws *workspace, user, id int64) {
+	if id == 0 {
+		return
+	}
+	if ws.customRoles == nil {
+		ws.customRoles = make(map[int64]int64)
+	}
+	ws.customRoles[user] = id
+}
+
+func (s *Server) renderMember(ws *workspace, id int64) object {
+	user := s.renderUser(id)
+	if u := s.lookupUser(id); u != nil {
+		user["role"] = u.Role
+	}
+	user["custom_role"] = nil
+	if role, ok := ws.customRoles[id]; ok {
+		user["custom_role"] = object{"id": role}
+	}
+	return object{"user": user}
+}
@@ -0,0 +1,326 @@
+package clickuptest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// maxWebhookFailures is the number of failed deliveries after which a
+// webhook is suspended, until it is reactivated by an update.
+const maxWebhookFailures = 100
+
+type webhook struct {
+	id        string
+	teamID    string
+	userID    int64
+	endpoint  string
+	events    []string
+	spaceID   string
+	folderID  string
+	listID    string
+	taskID    string
+	secret    string
+	suspended bool
+	failCount int
+}
+
+// event is a change reported to webhooks.
+type event struct {
+	name     string
+	teamID   string
+	spaceID  string
+	folderID string
+	listID   string
+	taskID   string
+	items    []object
+}
+
+func (c *call) emit(e event) {
+	c.events = append(c.events, e)
+}
+
+func (s *Server) spaceEvent(name string, sp *space) event {
+	return event{name: name, teamID: sp.teamID, spaceID: sp.id}
+}
+
+func (s *Server) folderEvent(name string, f *folder) event {
+	e := event{name: name, spaceID: f.spaceID, folderID: f.id}
+	if sp := s.spaces[f.spaceID]; sp != nil {
+		e.teamID = sp.teamID
+	}
+	return e
+}
+
+func (s *Server) listEvent(name string, l *list) event {
+	e := event{name: name, spaceID: l.spaceID, folderID: l.folderID, listID: l.id}
+	if sp := s.spaces[l.spaceID]; sp != nil {
+		e.teamID = sp.teamID
+	}
+	return e
+}
+
+func (s *Server) taskEvent(name string, t *task, items ...object) event {
+	e := event{name: name, taskID: t.id, items: items}
+	if l := s.lists[t.listID]; l != nil {
+		le := s.listEvent(name, l)
+		e.teamID, e.spaceID, e.folderID, e.listID = le.teamID, le.spaceID, le.folderID, le.listID
+	}
+	return e
+}
+
+func (s *Server) historyItem(field string, before, after interface{}) object {
+	return object{
+		"id":     s.newID(),
+		"type":   1,
+		"date":   millis(s.Now()),
+		"field":  field,
+		"user":   s.renderUser(s.User.ID),
+		"before": before,
+		"after":  after,
+	}
+}
+
+// payload returns the body delivered to w for e.
+func (e event) payload(w *webhook) object {
+	p := object{"event": e.name, "webhook_id": w.id}
+	switch {
+	case e.taskID != "":
+		p["task_id"] = e.taskID
+	case e.listID != "":
+		p["list_id"] = e.listID
+	case e.folderID != "":
+		p["folder_id"] = e.folderID
+	default:
+		p["space_id"] = e.spaceID
+	}
+	if len(e.items) > 0 {
+		p["history_items"] = e.items
+	}
+	return p
+}
+
+// matches reports whether w subscribes to e.
+func (w *webhook) matches(e event) bool {
+	if w.suspended || w.teamID != e.teamID {
+		return false
+	}
+	if w.taskID != "" && w.taskID != e.taskID ||
+		w.listID != "" && w.listID != e.listID ||
+		w.folderID != "" && w.folderID != e.folderID ||
+		w.spaceID != "" && w.spaceID != e.spaceID {
+		return false
+	}
+	for _, name := range w.events {
+		if name == "*" || name == e.name {
+			return true
+		}
+	}
+	return false
+}
+
+// deliver posts events to the webhooks subscribed to them, signing each body
+// with the webhook secret in the X-Signature header like ClickUp.
+func (s *Server) deliver(events []event) {
+	type delivery struct {
+		webhook  *webhook
+		endpoint string
+		body     []byte
+	}
+	var deliveries []delivery
+	s.mu.Lock()
+	for _, e := range events {
+		for _, w := range s.sortedWebhooks() {
+			if !w.matches(e) {
+				continue
+			}
+			body, _ := json.Marshal(e.payload(w))
+			deliveries = append(deliveries, delivery{w, w.endpoint, body})
+		}
+	}
+	client := s.WebhookClient
+	s.mu.Unlock()
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for _, d := range deliveries {
+		req, err := http.NewRequest(http.MethodPost, d.endpoint, bytes.NewReader(d.body))
+		if err != nil {
+			s.recordDelivery(d.webhook, false)
+			continue
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Signature", Sign(d.webhook.secret, d.body))
+		resp, err := client.Do(req)
+		ok := err == nil && resp.StatusCode < 300
+		if err == nil {
+			resp.Body.Close()
+		}
+		s.recordDelivery(d.webhook, ok)
+	}
+}
+
+func (s *Server) recordDelivery(w *webhook, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ok {
+		w.failCount = 0
+		return
+	}
+	w.failCount++
+	if w.failCount >= maxWebhookFailures {
+		w.suspended = true
+	}
+}
+
+// Sign returns the signature ClickUp sends in the X-Signature header of a
+// webhook delivery: the hex encoded HMAC-SHA256 of body keyed with the
+// webhook secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Server) renderWebhook(w *webhook) object {
+	status := "active"
+	switch {
+	case w.suspended:
+		status = "suspended"
+	case w.failCount > 0:
+		status = "failing"
+	}
+	teamID, _ := strconv.ParseInt(w.teamID, 10, 64)
+	return object{
+		"id":        w.id,
+		"userid":    w.userID,
+		"team_id":   teamID,
+		"endpoint":  w.endpoint,
+		"client_id": "",
+		"events":    w.events,
+		"task_id":   nullable(w.taskID),
+		"list_id":   nullable(w.listID),
+		"folder_id": nullable(w.folderID),
+		"space_id":  nullable(w.spaceID),
+		"health":    object{"status": status, "fail_count": w.failCount},
+		"secret":    w.secret,
+	}
+}
+
+func nullable(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// webhookRequest is the body of the webhook endpoints.
+type webhookRequest struct {
+	Endpoint *string  `json:"endpoint"`
+	Events   []string `json:"events"`
+	Status   *string  `json:"status"`
+	SpaceID  string   `json:"space_id"`
+	FolderID string   `json:"folder_id"`
+	ListID   string   `json:"list_id"`
+	TaskID   string   `json:"task_id"`
+}
+
+func (s *Server) getWebhooks(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	webhooks := []object{}
+	for _, w := range s.sortedWebhooks() {
+		if w.teamID == ws.id {
+			webhooks = append(webhooks, s.renderWebhook(w))
+		}
+	}
+	return object{"webhooks": webhooks}, nil
+}
+
+func (s *Server) sortedWebhooks() []*webhook {
+	var out []*webhook
+	for _, w := range s.webhooks {
+		out = append(out, w)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].id < out[j].id })
+	return out
+}
+
+func (s *Server) createWebhook(c *call) (interface{}, *Error) {
+	ws, err := s.workspace(c.ids[0])
+	if err != nil {
+		return nil, err
+	}
+	var req webhookRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Endpoint == nil || *req.Endpoint == "" {
+		return nil, badRequest("Endpoint invalid")
+	}
+	if len(req.Events) == 0 {
+		return nil, badRequest("Events invalid")
+	}
+	secret := make([]byte, 32)
+	rand.Read(secret)
+	w := &webhook{
+		id:       s.newUUID(),
+		teamID:   ws.id,
+		userID:   s.User.ID,
+		endpoint: *req.Endpoint,
+		events:   req.Events,
+		spaceID:  req.SpaceID,
+		folderID: req.FolderID,
+		listID:   req.ListID,
+		taskID:   req.TaskID,
+		secret:   hex.EncodeToString(secret),
+	}
+	s.webhooks[w.id] = w
+	return object{"id": w.id, "webhook": s.renderWebhook(w)}, nil
+}
+
+func (s *Server) updateWebhook(c *call) (interface{}, *Error) {
+	w, ok := s.webhooks[c.ids[0]]
+	if !ok {
+		return nil, notFound("Webhook")
+	}
+	var req webhookRequest
+	if err := c.decode(&req); err != nil {
+		return nil, err
+	}
+	if req.Endpoint != nil && *req.Endpoint != "" {
+		w.endpoint = *req.Endpoint
+	}
+	if len(req.Events) > 0 {
+		w.events = req.Events
+	}
+	if req.Status != nil {
+		switch *req.Status {
+		case "active":
+			w.suspended = false
+			w.failCount = 0
+		case "suspended":
+			w.suspended = true
+		default:
+			return nil, badRequest("Status invalid")
+		}
+	}
+	return object{"id": w.id, "webhook": s.renderWebhook(w)}, nil
+}
+
+func (s *Server) deleteWebhook(c *call) (interface{}, *Error) {
+	if _, ok := s.webhooks[c.ids[0]]; !ok {
+		return nil, notFound("Webhook")
+	}
+	delete(s.webhooks, c.ids[0])
+	return object{}, nil
+}
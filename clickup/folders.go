@@ -62,8 +62,6 @@ func (s *FoldersService) Get(ctx context.Context, folderID string, query string)
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(Folder)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -79,8 +77,6 @@ func (s *FoldersService) List(ctx context.Context, spaceID string, query string)
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(FoldersWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -96,8 +92,6 @@ func (s *FoldersService) Views(ctx context.Context, folderID string, query strin
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(ViewsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -106,3 +100,26 @@ func (s *FoldersService) Views(ctx context.Context, folderID string, query strin
 
 	return wResp, resp, nil
 }
+
+// CreateFromTemplate creates a folder called name in a space from a folder
+// template.
+func (s *FoldersService) CreateFromTemplate(ctx context.Context, spaceID string, templateID string, name string) (*Folder, *Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("space/%s/folder_template/%s", spaceID, templateID), &templateRequest{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(struct {
+		ID     string `json:"id"`
+		Folder Folder `json:"folder"`
+	})
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if wResp.Folder.ID == "" {
+		wResp.Folder.ID = wResp.ID
+	}
+	return &wResp.Folder, resp, nil
+}
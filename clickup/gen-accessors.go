@@ -0,0 +1,277 @@
+//go:build ignore
+// +build ignore
+
+// gen-accessors generates clickup-accessors.go with a nil-safe GetX method
+// for every pointer field X of the model structs of package clickup, the
+// exported structs with at least one json-tagged field or a MarshalJSON
+// method, such as request structs encoding their own body. It is run by
+// go generate.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const fileSuffix = "-accessors.go"
+
+var verbose = flag.Bool("v", false, "print verbose log messages")
+
+type getter struct {
+	sortVal  string // lower-case version of "ReceiverType.FieldName"
+	pkg      string // package of the field type, if not this one
+	Receiver string // receiver name
+	Type     string // receiver type
+	Field    string
+	FieldTyp string // type returned by the accessor
+	Zero     string // returned when the receiver or field is nil; "" for pointers
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-accessors: ")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for pkgName, pkg := range pkgs {
+		types := declaredTypes(pkg)
+		marshalers := jsonMarshalers(pkg)
+		var getters []*getter
+		imports := make(map[string]bool)
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				gd, ok := decl.(*ast.GenDecl)
+				if !ok || gd.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gd.Specs {
+					ts := spec.(*ast.TypeSpec)
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok || !ast.IsExported(ts.Name.Name) || !isModel(st) && !marshalers[ts.Name.Name] {
+						continue
+					}
+					for _, g := range structGetters(ts.Name.Name, st, types) {
+						if g.pkg != "" {
+							imports[importPath(f, g.pkg)] = true
+						}
+						getters = append(getters, g)
+					}
+				}
+			}
+		}
+		sort.Slice(getters, func(i, j int) bool { return getters[i].sortVal < getters[j].sortVal })
+		var paths []string
+		for path := range imports {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, struct {
+			Package string
+			Imports []string
+			Getters []*getter
+		}{pkgName, paths, getters}); err != nil {
+			log.Fatal(err)
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			log.Fatalf("formatting generated code: %v\n%s", err, buf.Bytes())
+		}
+		filename := pkgName + fileSuffix
+		if *verbose {
+			log.Printf("writing %v accessors to %v", len(getters), filename)
+		}
+		if err := ioutil.WriteFile(filename, src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// sourceFilter selects the hand-written source files of the package.
+func sourceFilter(fi os.FileInfo) bool {
+	name := fi.Name()
+	return !strings.HasSuffix(name, "_test.go") &&
+		!strings.HasPrefix(name, "gen-") &&
+		!strings.HasPrefix(name, "clickup-")
+}
+
+// declaredTypes returns the types declared in pkg by name.
+func declaredTypes(pkg *ast.Package) map[string]ast.Expr {
+	types := make(map[string]ast.Expr)
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				types[ts.Name.Name] = ts.Type
+			}
+		}
+	}
+	return types
+}
+
+// jsonMarshalers returns the names of the types of pkg with a MarshalJSON
+// method.
+func jsonMarshalers(pkg *ast.Package) map[string]bool {
+	names := make(map[string]bool)
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || fd.Name.Name != "MarshalJSON" {
+				continue
+			}
+			typ := fd.Recv.List[0].Type
+			if se, ok := typ.(*ast.StarExpr); ok {
+				typ = se.X
+			}
+			if id, ok := typ.(*ast.Ident); ok {
+				names[id.Name] = true
+			}
+		}
+	}
+	return names
+}
+
+// importPath returns the path of the package imported by f as name.
+func importPath(f *ast.File, name string) string {
+	for _, imp := range f.Imports {
+		path := strings.Trim(imp.Path.Value, `"`)
+		if imp.Name != nil && imp.Name.Name == name || imp.Name == nil && path[strings.LastIndex(path, "/")+1:] == name {
+			return path
+		}
+	}
+	log.Fatalf("%v: no import of package %v", f.Name.Name, name)
+	return ""
+}
+
+// isModel reports whether st has a json-tagged field.
+func isModel(st *ast.StructType) bool {
+	for _, fl := range st.Fields.List {
+		if fl.Tag != nil && strings.Contains(fl.Tag.Value, `json:"`) {
+			return true
+		}
+	}
+	return false
+}
+
+func structGetters(typ string, st *ast.StructType, types map[string]ast.Expr) []*getter {
+	var getters []*getter
+	for _, fl := range st.Fields.List {
+		se, ok := fl.Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		for _, n := range fl.Names {
+			if !ast.IsExported(n.Name) {
+				continue
+			}
+			g := &getter{
+				sortVal:  strings.ToLower(typ) + "." + strings.ToLower(n.Name),
+				Receiver: strings.ToLower(typ[:1]),
+				Type:     typ,
+				Field:    n.Name,
+			}
+			switch x := se.X.(type) {
+			case *ast.Ident:
+				g.FieldTyp, g.Zero = identResult(x.Name, types)
+			case *ast.SelectorExpr:
+				pkg, ok := x.X.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				g.pkg = pkg.Name
+				g.FieldTyp = "*" + pkg.Name + "." + x.Sel.Name
+			default:
+				// Anonymous structs and the like have no type name to return.
+				if *verbose {
+					log.Printf("skipping %v.%v: unsupported type %T", typ, n.Name, x)
+				}
+				continue
+			}
+			getters = append(getters, g)
+		}
+	}
+	return getters
+}
+
+// identResult returns the result type and zero value of the accessor of a
+// field of type *name. Timestamps and non-struct types are dereferenced;
+// other structs are returned as pointers.
+func identResult(name string, types map[string]ast.Expr) (typ, zero string) {
+	if name == "Timestamp" {
+		return name, "Timestamp{}"
+	}
+	if z, ok := basicZero(name); ok {
+		return name, z
+	}
+	switch t := types[name].(type) {
+	case *ast.Ident:
+		if z, ok := basicZero(t.Name); ok {
+			return name, name + "(" + z + ")"
+		}
+	case *ast.MapType, *ast.ArrayType, *ast.InterfaceType:
+		return name, "nil"
+	}
+	return "*" + name, ""
+}
+
+func basicZero(name string) (string, bool) {
+	switch name {
+	case "bool":
+		return "false", true
+	case "string":
+		return `""`, true
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64", "byte", "rune":
+		return "0", true
+	}
+	return "", false
+}
+
+var tmpl = template.Must(template.New("accessors").Parse(`// Code generated by gen-accessors; DO NOT EDIT.
+// Instead, please run "go generate ./...".
+
+package {{.Package}}
+{{with .Imports}}
+import (
+{{- range .}}
+	"{{.}}"
+{{- end}}
+)
+{{end}}{{range .Getters}}{{if .Zero}}
+// Get{{.Field}} returns the {{.Field}} field if it's non-nil, zero value otherwise.
+func ({{.Receiver}} *{{.Type}}) Get{{.Field}}() {{.FieldTyp}} {
+	if {{.Receiver}} == nil || {{.Receiver}}.{{.Field}} == nil {
+		return {{.Zero}}
+	}
+	return *{{.Receiver}}.{{.Field}}
+}
+{{else}}
+// Get{{.Field}} returns the {{.Field}} field.
+func ({{.Receiver}} *{{.Type}}) Get{{.Field}}() {{.FieldTyp}} {
+	if {{.Receiver}} == nil {
+		return nil
+	}
+	return {{.Receiver}}.{{.Field}}
+}
+{{end}}{{end}}`))
@@ -0,0 +1,322 @@
+//go:build ignore
+// +build ignore
+
+// gen-stringify-test generates clickup-stringify.go with a String method
+// calling Stringify for every model struct of package clickup, the exported
+// structs with at least one json-tagged field that have neither a String nor
+// an Error method, and clickup-stringify_test.go with a table test of those
+// with fields it can set. It is run by go generate.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	fileSuffix     = "-stringify.go"
+	testFileSuffix = "-stringify_test.go"
+)
+
+var verbose = flag.Bool("v", false, "print verbose log messages")
+
+// pointerFuncs are the helpers allocating a value for a pointer field, by
+// pointed-to type.
+var pointerFuncs = map[string]string{
+	"bool":   "Bool",
+	"int":    "Int",
+	"int64":  "Int64",
+	"string": "String",
+}
+
+type stringer struct {
+	Type string
+	// Fields are the fields set in the test, as "Name: value", and Want the
+	// strings expected in the output for them.
+	Fields []string
+	Want   []string
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-stringify-test: ")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for pkgName, pkg := range pkgs {
+		methods := declaredMethods(pkg)
+		structs := declaredStructs(pkg)
+		var stringers []*stringer
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				gd, ok := decl.(*ast.GenDecl)
+				if !ok || gd.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gd.Specs {
+					ts := spec.(*ast.TypeSpec)
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok || !ast.IsExported(ts.Name.Name) || !isModel(st) {
+						continue
+					}
+					name := ts.Name.Name
+					if methods[name+".String"] || methods[name+".Error"] {
+						if *verbose {
+							log.Printf("skipping %v: it has a String or Error method", name)
+						}
+						continue
+					}
+					stringers = append(stringers, newStringer(pkgName, name, st, structs))
+				}
+			}
+		}
+		sort.Slice(stringers, func(i, j int) bool { return stringers[i].Type < stringers[j].Type })
+
+		data := struct {
+			Package   string
+			Stringers []*stringer
+		}{pkgName, stringers}
+		write(pkgName+fileSuffix, stringTmpl, data)
+		write(pkgName+testFileSuffix, testTmpl, data)
+		if *verbose {
+			log.Printf("wrote %v String methods", len(stringers))
+		}
+	}
+}
+
+func write(filename string, tmpl *template.Template, data interface{}) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated code: %v\n%s", err, buf.Bytes())
+	}
+	if err := ioutil.WriteFile(filename, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// sourceFilter selects the hand-written source files of the package.
+func sourceFilter(fi os.FileInfo) bool {
+	name := fi.Name()
+	return !strings.HasSuffix(name, "_test.go") &&
+		!strings.HasPrefix(name, "gen-") &&
+		!strings.HasPrefix(name, "clickup-")
+}
+
+// declaredMethods returns the methods declared in pkg, as "Type.Method".
+func declaredMethods(pkg *ast.Package) map[string]bool {
+	methods := make(map[string]bool)
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil {
+				continue
+			}
+			recv := fd.Recv.List[0].Type
+			if se, ok := recv.(*ast.StarExpr); ok {
+				recv = se.X
+			}
+			if id, ok := recv.(*ast.Ident); ok {
+				methods[id.Name+"."+fd.Name.Name] = true
+			}
+		}
+	}
+	return methods
+}
+
+// declaredStructs returns the names of the struct types declared in pkg.
+func declaredStructs(pkg *ast.Package) map[string]bool {
+	structs := make(map[string]bool)
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if _, ok := ts.Type.(*ast.StructType); ok {
+					structs[ts.Name.Name] = true
+				}
+			}
+		}
+	}
+	return structs
+}
+
+// isModel reports whether st has a json-tagged field.
+func isModel(st *ast.StructType) bool {
+	for _, fl := range st.Fields.List {
+		if fl.Tag != nil && strings.Contains(fl.Tag.Value, `json:"`) {
+			return true
+		}
+	}
+	return false
+}
+
+// newStringer returns the stringer of the struct typ, setting the fields of
+// basic types, of struct types declared in the package, and pointers to or
+// slices of them in the test.
+func newStringer(pkg, typ string, st *ast.StructType, structs map[string]bool) *stringer {
+	s := &stringer{Type: typ}
+	for _, fl := range st.Fields.List {
+		for _, n := range fl.Names {
+			if !ast.IsExported(n.Name) {
+				continue
+			}
+			value, want, ok := fieldValue(pkg, n.Name, fl.Type, structs)
+			if !ok {
+				continue
+			}
+			s.Fields = append(s.Fields, n.Name+": "+value)
+			s.Want = append(s.Want, n.Name+":"+want)
+		}
+	}
+	return s
+}
+
+// fieldValue returns the value set in the test for the field name of type
+// typ and how Stringify prints it, or its beginning for structs.
+func fieldValue(pkg, name string, typ ast.Expr, structs map[string]bool) (value, want string, ok bool) {
+	if at, ok := typ.(*ast.ArrayType); ok {
+		if at.Len != nil {
+			return "", "", false
+		}
+		id, ok := at.Elt.(*ast.Ident)
+		if !ok {
+			return "", "", false
+		}
+		if structs[id.Name] {
+			return "[]" + id.Name + "{{}}", "[" + pkg + "." + id.Name + "{", true
+		}
+		value, want, ok := basicValue(name, id.Name)
+		if !ok {
+			return "", "", false
+		}
+		return "[]" + id.Name + "{" + value + "}", "[" + want + "]", true
+	}
+
+	ptr := false
+	if se, ok := typ.(*ast.StarExpr); ok {
+		typ, ptr = se.X, true
+	}
+	id, ok := typ.(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	if structs[id.Name] {
+		value = id.Name + "{}"
+		if ptr {
+			value = "&" + value
+		}
+		return value, pkg + "." + id.Name + "{", true
+	}
+	value, want, ok = basicValue(name, id.Name)
+	if !ok {
+		return "", "", false
+	}
+	if ptr {
+		f, ok := pointerFuncs[id.Name]
+		if !ok {
+			return "", "", false
+		}
+		value = f + "(" + value + ")"
+	}
+	return value, want, true
+}
+
+// basicValue returns the value set in the test for the field name of the
+// basic type typ and how Stringify prints it.
+func basicValue(name, typ string) (value, want string, ok bool) {
+	switch typ {
+	case "bool":
+		return "true", "true", true
+	case "string":
+		return fmt.Sprintf("%q", name), fmt.Sprintf("%q", name), true
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64":
+		return "1", "1", true
+	case "float32", "float64":
+		return "1.5", "1.5", true
+	}
+	return "", "", false
+}
+
+var stringTmpl = template.Must(template.New("stringify").Parse(`// Code generated by gen-stringify-test; DO NOT EDIT.
+// Instead, please run "go generate ./...".
+
+package {{.Package}}
+{{range .Stringers}}
+func (v {{.Type}}) String() string {
+	return Stringify(v)
+}
+{{end}}`))
+
+var testTmpl = template.Must(template.New("stringify_test").Funcs(template.FuncMap{
+	"quote": func(s string) string {
+		if strings.Contains(s, "`") {
+			return fmt.Sprintf("%q", s)
+		}
+		return "`" + s + "`"
+	},
+}).Parse(`// Code generated by gen-stringify-test; DO NOT EDIT.
+// Instead, please run "go generate ./...".
+
+package {{.Package}}
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		in   fmt.Stringer
+		want []string
+	}{
+{{- range .Stringers}}{{if .Fields}}
+		{
+			{{.Type}}{ {{- range $i, $f := .Fields}}{{if $i}}, {{end}}{{$f}}{{end -}} },
+			[]string{ {{- range $i, $w := .Want}}{{if $i}}, {{end}}{{quote $w}}{{end -}} },
+		},
+{{- end}}{{end}}
+	}
+
+	for _, tt := range tests {
+		got := tt.in.String()
+		prefix := fmt.Sprintf("%T{", tt.in)
+		if !strings.HasPrefix(got, prefix) {
+			t.Errorf("%T.String() = %v, want prefix %v", tt.in, got, prefix)
+			continue
+		}
+		if got != Stringify(tt.in) {
+			t.Errorf("%T.String() = %v, want Stringify output %v", tt.in, got, Stringify(tt.in))
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(got, "{"+want) && !strings.Contains(got, " "+want) {
+				t.Errorf("%T.String() = %v, missing %v", tt.in, got, want)
+			}
+		}
+	}
+}
+`))
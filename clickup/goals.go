@@ -62,8 +62,6 @@ func (s *GoalsService) List(ctx context.Context, workspaceID string, query strin
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(GoalsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -79,8 +77,6 @@ func (s *GoalsService) Get(ctx context.Context, goalID string, query string) (*G
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(GoalWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Schedule is the earliest possible timing of a task given the estimates of
+// everything it depends on, and how much room its due date (or the due dates
+// of the tasks waiting on it) leaves.
+type Schedule struct {
+	EarliestStart  time.Time
+	EarliestFinish time.Time
+	LatestFinish   time.Time
+
+	// Slack is LatestFinish - EarliestFinish. A negative slack means a due
+	// date cannot be met even if work starts now.
+	Slack time.Duration
+}
+
+// CriticalPath is the chain of dependent tasks that determines when the
+// tightest task in the graph can be finished.
+type CriticalPath struct {
+	// Tasks holds task IDs in the order they have to be worked on.
+	Tasks []string
+	// Duration is the sum of the time estimates along the path.
+	Duration time.Duration
+	// Slack is the slack of the last task on the path.
+	Slack time.Duration
+	// Schedule holds the computed timing of every task in the graph.
+	Schedule map[string]Schedule
+}
+
+// CriticalPath schedules every task as early as its dependencies allow,
+// starting at start, with each task taking its TimeEstimate (tasks without an
+// estimate take no time). Latest finish times are derived from due dates,
+// falling back to the overall finish for tasks nothing constrains.
+//
+// The path returned ends at the task with the least slack and follows, at each
+// step, the dependency that finishes last. Without any due dates this is the
+// longest chain of estimates in the graph. If the graph has cycles a
+// *CycleError is returned.
+func (g *Graph) CriticalPath(start time.Time) (*CriticalPath, error) {
+	order, err := g.TopologicalOrder()
+	if err != nil {
+		return nil, err
+	}
+	if len(order) == 0 {
+		return &CriticalPath{Schedule: map[string]Schedule{}}, nil
+	}
+
+	sched := make(map[string]Schedule, len(order))
+	driver := make(map[string]string, len(order))
+	end := start
+	for _, id := range order {
+		s := Schedule{EarliestStart: start}
+		for _, dep := range g.nodes[id].DependsOn {
+			if driver[id] == "" || sched[dep].EarliestFinish.After(s.EarliestStart) {
+				driver[id] = dep
+				s.EarliestStart = sched[dep].EarliestFinish
+			}
+		}
+		s.EarliestFinish = s.EarliestStart.Add(Estimate(g.nodes[id].Task))
+		if s.EarliestFinish.After(end) {
+			end = s.EarliestFinish
+		}
+		sched[id] = s
+	}
+
+	for i := len(order) - 1; i >= 0; i-- {
+		id := order[i]
+		s := sched[id]
+		s.LatestFinish = end
+		if due, ok := DueDate(g.nodes[id].Task); ok {
+			s.LatestFinish = due
+		}
+		for _, dependent := range g.nodes[id].Dependents {
+			d := sched[dependent]
+			ls := d.LatestFinish.Add(-Estimate(g.nodes[dependent].Task))
+			if ls.Before(s.LatestFinish) {
+				s.LatestFinish = ls
+			}
+		}
+		s.Slack = s.LatestFinish.Sub(s.EarliestFinish)
+		sched[id] = s
+	}
+
+	last := order[0]
+	for _, id := range order[1:] {
+		s, l := sched[id], sched[last]
+		if s.Slack < l.Slack || (s.Slack == l.Slack && s.EarliestFinish.After(l.EarliestFinish)) {
+			last = id
+		}
+	}
+
+	var path []string
+	for id := last; id != ""; id = driver[id] {
+		path = append(path, id)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return &CriticalPath{
+		Tasks:    path,
+		Duration: sched[last].EarliestFinish.Sub(start),
+		Slack:    sched[last].Slack,
+		Schedule: sched,
+	}, nil
+}
+
+// Estimate returns the TimeEstimate of a task, or zero when none is set.
+func Estimate(t clickup.Task) time.Duration {
+	switch v := t.TimeEstimate.(type) {
+	case float64:
+		return time.Duration(v) * time.Millisecond
+	case string:
+		ms, err := strconv.ParseInt(v, 10, 64)
+		if err == nil {
+			return time.Duration(ms) * time.Millisecond
+		}
+	}
+	return 0
+}
+
+// DueDate returns the due date of a task and whether one is set.
+func DueDate(t clickup.Task) (time.Time, bool) {
+	ms, err := strconv.ParseInt(t.DueDate, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)), true
+}
@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteDOT writes the graph in Graphviz DOT format. Edges point from a task
+// to the tasks waiting on it, so blockers appear first. Tasks listed in
+// highlight are drawn in red, and so are the edges between consecutive ones,
+// so that highlighting CriticalPath.Tasks draws the path.
+func (g *Graph) WriteDOT(w io.Writer, highlight ...string) error {
+	marked := markSet(highlight)
+	path := pathEdges(highlight)
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "digraph tasks {")
+	fmt.Fprintln(bw, "\trankdir=LR;")
+	fmt.Fprintln(bw, "\tnode [shape=box];")
+	for _, id := range g.ids {
+		attrs := fmt.Sprintf("label=%s", dotQuote(g.label(id)))
+		if marked[id] {
+			attrs += ", color=red, penwidth=2"
+		}
+		fmt.Fprintf(bw, "\t%s [%s];\n", dotQuote(id), attrs)
+	}
+	for _, id := range g.ids {
+		for _, dependent := range g.nodes[id].Dependents {
+			attrs := ""
+			if path[[2]string{id, dependent}] {
+				attrs = " [color=red, penwidth=2]"
+			}
+			fmt.Fprintf(bw, "\t%s -> %s%s;\n", dotQuote(id), dotQuote(dependent), attrs)
+		}
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart, with the same edge
+// direction and highlighting as WriteDOT.
+func (g *Graph) WriteMermaid(w io.Writer, highlight ...string) error {
+	marked := markSet(highlight)
+	path := pathEdges(highlight)
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "flowchart LR")
+	for _, id := range g.ids {
+		fmt.Fprintf(bw, "\t%s[\"%s\"]\n", mermaidID(id), mermaidEscape(g.label(id)))
+	}
+	// Mermaid styles links by their position in the chart.
+	var links []string
+	n := 0
+	for _, id := range g.ids {
+		for _, dependent := range g.nodes[id].Dependents {
+			fmt.Fprintf(bw, "\t%s --> %s\n", mermaidID(id), mermaidID(dependent))
+			if path[[2]string{id, dependent}] {
+				links = append(links, strconv.Itoa(n))
+			}
+			n++
+		}
+	}
+	if len(marked) > 0 {
+		fmt.Fprintln(bw, "\tclassDef critical stroke:#d00,stroke-width:2px")
+		for _, id := range g.ids {
+			if marked[id] {
+				fmt.Fprintf(bw, "\tclass %s critical\n", mermaidID(id))
+			}
+		}
+	}
+	if len(links) > 0 {
+		fmt.Fprintf(bw, "\tlinkStyle %s stroke:#d00,stroke-width:2px\n", strings.Join(links, ","))
+	}
+	return bw.Flush()
+}
+
+func (g *Graph) label(id string) string {
+	if name := g.nodes[id].Task.Name; name != "" {
+		return name
+	}
+	return id
+}
+
+func markSet(ids []string) map[string]bool {
+	m := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		m[id] = true
+	}
+	return m
+}
+
+// pathEdges returns the edges from each task of path to the next one, as
+// [dependency, dependent] pairs.
+func pathEdges(path []string) map[[2]string]bool {
+	m := make(map[[2]string]bool, len(path))
+	for i := 1; i < len(path); i++ {
+		m[[2]string{path[i-1], path[i]}] = true
+	}
+	return m
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+// mermaidID turns a task ID into a node identifier Mermaid accepts. Letters
+// and digits are kept and every other byte is written as "_" followed by two
+// hex digits, so that distinct IDs such as "DEV-12" and "DEV_12" stay
+// distinct.
+func mermaidID(id string) string {
+	var b strings.Builder
+	b.WriteString("t_")
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "_%02x", c)
+		}
+	}
+	return b.String()
+}
+
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", " ").Replace(s)
+}
@@ -0,0 +1,209 @@
+// Package graph builds a dependency graph from ClickUp tasks so that blocking
+// chains can be analysed: cycle detection, topological ordering, critical
+// path scheduling and export to Graphviz DOT and Mermaid.
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Node is a task in the graph along with its edges. Only edges to tasks that
+// are part of the graph are recorded.
+type Node struct {
+	Task clickup.Task
+
+	// DependsOn holds the IDs of the tasks this task waits on.
+	DependsOn []string
+	// Dependents holds the IDs of the tasks waiting on this task.
+	Dependents []string
+}
+
+// Graph is a dependency graph of tasks. Edges point from a task to the tasks
+// it depends on.
+type Graph struct {
+	nodes map[string]*Node
+	ids   []string // insertion order, so output is deterministic
+}
+
+// CycleError is returned when an operation needs an acyclic graph but the
+// dependencies form one or more cycles.
+type CycleError struct {
+	Cycles [][]string
+}
+
+func (e *CycleError) Error() string {
+	parts := make([]string, len(e.Cycles))
+	for i, c := range e.Cycles {
+		parts[i] = strings.Join(c, " -> ")
+	}
+	return fmt.Sprintf("dependency cycle: %s", strings.Join(parts, "; "))
+}
+
+// New builds a graph from tasks using their Dependencies. A dependency may be
+// reported by either of the two tasks involved, so duplicates are collapsed.
+// Dependencies on tasks not in tasks are ignored.
+func New(tasks []clickup.Task) *Graph {
+	g := &Graph{nodes: make(map[string]*Node, len(tasks))}
+	for _, t := range tasks {
+		if _, ok := g.nodes[t.ID]; ok {
+			continue
+		}
+		g.nodes[t.ID] = &Node{Task: t}
+		g.ids = append(g.ids, t.ID)
+	}
+
+	seen := make(map[[2]string]bool)
+	for _, id := range g.ids {
+		for _, d := range g.nodes[id].Task.Dependencies {
+			g.addEdge(d.TaskID, d.DependsOn, seen)
+		}
+	}
+	return g
+}
+
+func (g *Graph) addEdge(from, to string, seen map[[2]string]bool) {
+	if seen[[2]string{from, to}] {
+		return
+	}
+	f, ok := g.nodes[from]
+	if !ok {
+		return
+	}
+	t, ok := g.nodes[to]
+	if !ok {
+		return
+	}
+	seen[[2]string{from, to}] = true
+	f.DependsOn = append(f.DependsOn, to)
+	t.Dependents = append(t.Dependents, from)
+}
+
+// Node returns the node for the task with the given ID.
+func (g *Graph) Node(id string) (*Node, bool) {
+	n, ok := g.nodes[id]
+	return n, ok
+}
+
+// IDs returns the task IDs in the order the tasks were given to New.
+func (g *Graph) IDs() []string {
+	return append([]string(nil), g.ids...)
+}
+
+// Len returns the number of tasks in the graph.
+func (g *Graph) Len() int {
+	return len(g.ids)
+}
+
+// Cycles returns every dependency cycle in the graph, each as the list of task
+// IDs involved. A nil result means the graph is acyclic.
+func (g *Graph) Cycles() [][]string {
+	// Tarjan's strongly connected components.
+	var (
+		index   = make(map[string]int, len(g.ids))
+		low     = make(map[string]int, len(g.ids))
+		onStack = make(map[string]bool, len(g.ids))
+		stack   []string
+		next    int
+		cycles  [][]string
+	)
+
+	var visit func(id string)
+	visit = func(id string) {
+		index[id] = next
+		low[id] = next
+		next++
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, dep := range g.nodes[id].DependsOn {
+			if _, ok := index[dep]; !ok {
+				visit(dep)
+				if low[dep] < low[id] {
+					low[id] = low[dep]
+				}
+			} else if onStack[dep] && index[dep] < low[id] {
+				low[id] = index[dep]
+			}
+		}
+
+		if low[id] != index[id] {
+			return
+		}
+		var scc []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			scc = append(scc, top)
+			if top == id {
+				break
+			}
+		}
+		if len(scc) > 1 || g.dependsOnItself(id) {
+			for i, j := 0, len(scc)-1; i < j; i, j = i+1, j-1 {
+				scc[i], scc[j] = scc[j], scc[i]
+			}
+			cycles = append(cycles, scc)
+		}
+	}
+
+	for _, id := range g.ids {
+		if _, ok := index[id]; !ok {
+			visit(id)
+		}
+	}
+	return cycles
+}
+
+func (g *Graph) dependsOnItself(id string) bool {
+	for _, dep := range g.nodes[id].DependsOn {
+		if dep == id {
+			return true
+		}
+	}
+	return false
+}
+
+// TopologicalOrder returns the task IDs ordered so that every task comes after
+// the tasks it depends on. Among tasks that are ready at the same time the
+// input order is kept. If the graph has cycles a *CycleError is returned.
+func (g *Graph) TopologicalOrder() ([]string, error) {
+	if cycles := g.Cycles(); cycles != nil {
+		return nil, &CycleError{Cycles: cycles}
+	}
+
+	position := make(map[string]int, len(g.ids))
+	pending := make(map[string]int, len(g.ids))
+	var ready []string
+	for i, id := range g.ids {
+		position[id] = i
+		pending[id] = len(g.nodes[id].DependsOn)
+		if pending[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	order := make([]string, 0, len(g.ids))
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		order = append(order, id)
+
+		var released []string
+		for _, dependent := range g.nodes[id].Dependents {
+			pending[dependent]--
+			if pending[dependent] == 0 {
+				released = append(released, dependent)
+			}
+		}
+		ready = append(ready, released...)
+		sort.SliceStable(ready, func(i, j int) bool {
+			return position[ready[i]] < position[ready[j]]
+		})
+	}
+	return order, nil
+}
@@ -0,0 +1,185 @@
+package graph
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// task returns a task estimated at hours that depends on deps.
+func task(id string, hours int, deps ...string) clickup.Task {
+	t := clickup.Task{ID: id}
+	if hours > 0 {
+		t.TimeEstimate = float64(time.Duration(hours) * time.Hour / time.Millisecond)
+	}
+	for _, d := range deps {
+		t.Dependencies = append(t.Dependencies, clickup.TaskDependency{TaskID: id, DependsOn: d})
+	}
+	return t
+}
+
+func due(t clickup.Task, at time.Time) clickup.Task {
+	t.DueDate = strconv.FormatInt(at.UnixNano()/int64(time.Millisecond), 10)
+	return t
+}
+
+// diamond is a task a that b and c depend on, and a task d that depends on
+// both, given in reverse order.
+func diamond() []clickup.Task {
+	return []clickup.Task{task("d", 1, "b", "c"), task("b", 1, "a"), task("c", 3, "a"), task("a", 2)}
+}
+
+func TestCycles(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks []clickup.Task
+		want  [][]string
+	}{
+		{"chain", []clickup.Task{task("a", 0), task("b", 0, "a"), task("c", 0, "b")}, nil},
+		{"diamond", diamond(), nil},
+		{"self", []clickup.Task{task("a", 0, "a")}, [][]string{{"a"}}},
+		{"pair", []clickup.Task{task("a", 0, "b"), task("b", 0, "a")}, [][]string{{"a", "b"}}},
+		{
+			"two cycles and a task waiting on one",
+			[]clickup.Task{task("a", 0, "b"), task("b", 0, "a"), task("c", 0, "d"), task("d", 0, "c"), task("e", 0, "a")},
+			[][]string{{"a", "b"}, {"c", "d"}},
+		},
+		{"dependency outside the graph", []clickup.Task{task("a", 0, "zzz")}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := New(tt.tasks).Cycles(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Cycles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopologicalOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks []clickup.Task
+		want  []string
+	}{
+		{"diamond", diamond(), []string{"a", "b", "c", "d"}},
+		{"independent tasks keep their order", []clickup.Task{task("x", 0), task("y", 0), task("z", 0)}, []string{"x", "y", "z"}},
+		{
+			"dependency reported by both tasks",
+			[]clickup.Task{task("a", 0, "b"), {ID: "b", Dependencies: []clickup.TaskDependency{{TaskID: "a", DependsOn: "b"}}}},
+			[]string{"b", "a"},
+		},
+		{"cycle", []clickup.Task{task("a", 0, "b"), task("b", 0, "a")}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.tasks).TopologicalOrder()
+			if tt.want == nil {
+				var cerr *CycleError
+				if !errors.As(err, &cerr) {
+					t.Fatalf("TopologicalOrder() error = %v, want a *CycleError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopologicalOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	late := diamond()
+	late[1] = due(late[1], start.Add(2*time.Hour))
+
+	tests := []struct {
+		name     string
+		tasks    []clickup.Task
+		path     []string
+		duration time.Duration
+		slack    time.Duration
+	}{
+		{"longest chain", diamond(), []string{"a", "c", "d"}, 6 * time.Hour, 0},
+		// b cannot finish before 3h, an hour after its due date.
+		{"missed due date", late, []string{"a", "b"}, 3 * time.Hour, -time.Hour},
+		{"empty", nil, nil, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cp, err := New(tt.tasks).CriticalPath(start)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(cp.Tasks, tt.path) || cp.Duration != tt.duration || cp.Slack != tt.slack {
+				t.Errorf("CriticalPath() = %v over %v with slack %v, want %v over %v with slack %v",
+					cp.Tasks, cp.Duration, cp.Slack, tt.path, tt.duration, tt.slack)
+			}
+		})
+	}
+
+	if _, err := New([]clickup.Task{task("a", 1, "a")}).CriticalPath(start); err == nil {
+		t.Error("CriticalPath() of a cycle succeeded")
+	}
+}
+
+func TestExport(t *testing.T) {
+	a := task("a", 0)
+	a.Name = `Say "hi"`
+	g := New([]clickup.Task{a, task("DEV-12", 0, "a"), task("DEV_12", 0, "DEV-12", "a"), task("d", 0, "a")})
+	path := []string{"a", "DEV-12", "DEV_12"}
+
+	tests := []struct {
+		name  string
+		write func(io.Writer, ...string) error
+		want  string
+	}{
+		{"dot", g.WriteDOT, `digraph tasks {
+	rankdir=LR;
+	node [shape=box];
+	"a" [label="Say \"hi\"", color=red, penwidth=2];
+	"DEV-12" [label="DEV-12", color=red, penwidth=2];
+	"DEV_12" [label="DEV_12", color=red, penwidth=2];
+	"d" [label="d"];
+	"a" -> "DEV-12" [color=red, penwidth=2];
+	"a" -> "DEV_12";
+	"a" -> "d";
+	"DEV-12" -> "DEV_12" [color=red, penwidth=2];
+}
+`},
+		{"mermaid", g.WriteMermaid, `flowchart LR
+	t_a["Say #quot;hi#quot;"]
+	t_DEV_2d12["DEV-12"]
+	t_DEV_5f12["DEV_12"]
+	t_d["d"]
+	t_a --> t_DEV_2d12
+	t_a --> t_DEV_5f12
+	t_a --> t_d
+	t_DEV_2d12 --> t_DEV_5f12
+	classDef critical stroke:#d00,stroke-width:2px
+	class t_a critical
+	class t_DEV_2d12 critical
+	class t_DEV_5f12 critical
+	linkStyle 0,3 stroke:#d00,stroke-width:2px
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.write(&buf, path...); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package clickup
 import (
 	"context"
 	"fmt"
+	"sort"
 )
 
 type GroupsService service
@@ -35,13 +36,61 @@ type Group struct {
 	} `json:"avatar"`
 }
 
+// GroupMembersUpdate lists the user IDs to add to and remove from a group.
+type GroupMembersUpdate struct {
+	Add    []int64 `json:"add"`
+	Remove []int64 `json:"rem"`
+}
+
+// GroupUpdate is the body of GroupsService.Update. Empty fields are left
+// unchanged.
+type GroupUpdate struct {
+	Name    string              `json:"name,omitempty"`
+	Handle  string              `json:"handle,omitempty"`
+	Members *GroupMembersUpdate `json:"members,omitempty"`
+}
+
+// GroupSyncResult reports the changes SyncGroups applied to a workspace.
+type GroupSyncResult struct {
+	Created []Group
+	Updated []Group
+	Deleted []Group
+}
+
+type groupsListOptions struct {
+	TeamID   string   `url:"team_id"`
+	GroupIDs []string `url:"group_ids,comma,omitempty"`
+}
+
+// Get lists user groups; query should carry the team_id. See List.
 func (s *GroupsService) Get(ctx context.Context, query string) (*GroupsWrapper, *Response, error) {
 	req, err := s.client.NewRequest("GET", fmt.Sprintf("group%s", query), nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
+	wResp := new(GroupsWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wResp, resp, nil
+
+}
+
+// List returns the user groups of a workspace, optionally only those with the
+// given IDs.
+func (s *GroupsService) List(ctx context.Context, teamID string, groupIDs ...string) (*GroupsWrapper, *Response, error) {
+	u, err := addOptions("group", &groupsListOptions{TeamID: teamID, GroupIDs: groupIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	wResp := new(GroupsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
@@ -50,5 +99,139 @@ func (s *GroupsService) Get(ctx context.Context, query string) (*GroupsWrapper,
 	}
 
 	return wResp, resp, nil
+}
+
+func (s *GroupsService) Create(ctx context.Context, teamID string, name string, handle string, members []int64) (*Group, *Response, error) {
+	body := struct {
+		Name    string  `json:"name"`
+		Handle  string  `json:"handle,omitempty"`
+		Members []int64 `json:"members"`
+	}{name, handle, members}
+	if body.Members == nil {
+		body.Members = []int64{}
+	}
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("team/%s/group", teamID), &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(Group)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wResp, resp, nil
+}
+
+func (s *GroupsService) Update(ctx context.Context, groupID string, update *GroupUpdate) (*Group, *Response, error) {
+	req, err := s.client.NewRequest("PUT", fmt.Sprintf("group/%s", groupID), update)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(Group)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wResp, resp, nil
+}
+
+func (s *GroupsService) Delete(ctx context.Context, groupID string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("group/%s", groupID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
 
+// SyncGroups makes the user groups of a workspace match desired, which maps
+// group names to the user IDs that should be members. Missing groups are
+// created and members are added or removed as needed. Groups not in desired
+// are only deleted when prune is set. The changes applied before any error are
+// reported in the result.
+func (s *GroupsService) SyncGroups(ctx context.Context, teamID string, desired map[string][]int64, prune bool) (*GroupSyncResult, *Response, error) {
+	current, resp, err := s.List(ctx, teamID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	existing := make(map[string]Group, len(current.Groups))
+	for _, g := range current.Groups {
+		existing[g.Name] = g
+	}
+
+	names := make([]string, 0, len(desired))
+	for name := range desired {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := new(GroupSyncResult)
+	for _, name := range names {
+		members := desired[name]
+		g, ok := existing[name]
+		if !ok {
+			var created *Group
+			created, resp, err = s.Create(ctx, teamID, name, "", members)
+			if err != nil {
+				return result, resp, err
+			}
+			result.Created = append(result.Created, *created)
+			continue
+		}
+
+		diff := membersDiff(g, members)
+		if len(diff.Add) == 0 && len(diff.Remove) == 0 {
+			continue
+		}
+		var updated *Group
+		updated, resp, err = s.Update(ctx, g.ID, &GroupUpdate{Members: diff})
+		if err != nil {
+			return result, resp, err
+		}
+		result.Updated = append(result.Updated, *updated)
+	}
+
+	if prune {
+		for _, g := range current.Groups {
+			if _, ok := desired[g.Name]; ok {
+				continue
+			}
+			resp, err = s.Delete(ctx, g.ID)
+			if err != nil {
+				return result, resp, err
+			}
+			result.Deleted = append(result.Deleted, g)
+		}
+	}
+
+	return result, resp, nil
+}
+
+func membersDiff(g Group, want []int64) *GroupMembersUpdate {
+	have := make(map[int64]bool, len(g.Members))
+	for _, m := range g.Members {
+		have[m.ID] = true
+	}
+	wanted := make(map[int64]bool, len(want))
+	diff := &GroupMembersUpdate{Add: []int64{}, Remove: []int64{}}
+	for _, id := range want {
+		if wanted[id] {
+			continue
+		}
+		wanted[id] = true
+		if !have[id] {
+			diff.Add = append(diff.Add, id)
+		}
+	}
+	for _, m := range g.Members {
+		if !wanted[m.ID] {
+			diff.Remove = append(diff.Remove, m.ID)
+		}
+	}
+	return diff
 }
@@ -0,0 +1,122 @@
+package clickup_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+func memberIDs(g clickup.Group) []int64 {
+	var ids []int64
+	for _, m := range g.Members {
+		ids = append(ids, m.ID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestGroups(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	jane := srv.AddUser(team, clickuptest.User{Username: "Jane Doe"})
+	ctx := context.Background()
+
+	g, _, err := client.Groups.Create(ctx, team, "Design Team", "design", []int64{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.ID == "" || g.TeamID != team || g.Handle != "design" || g.Initials != "DT" || len(g.Members) != 1 {
+		t.Errorf("created group = %+v", g)
+	}
+	if _, _, err := client.Groups.Create(ctx, team, "Outsiders", "", []int64{12345}); err == nil {
+		t.Error("creating a group with a non-member succeeded")
+	}
+
+	g, _, err = client.Groups.Update(ctx, g.ID, &clickup.GroupUpdate{Name: "Design", Members: &clickup.GroupMembersUpdate{Add: []int64{jane}, Remove: []int64{1}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := memberIDs(*g); g.Name != "Design" || len(got) != 1 || got[0] != jane {
+		t.Errorf("updated group %q has members %v, want [%d]", g.Name, got, jane)
+	}
+
+	other, _, err := client.Groups.Create(ctx, team, "Ops", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, _, err := client.Groups.List(ctx, team)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups.Groups) != 2 {
+		t.Errorf("listed %d groups, want 2", len(groups.Groups))
+	}
+	groups, _, err = client.Groups.List(ctx, team, other.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups.Groups) != 1 || groups.Groups[0].ID != other.ID {
+		t.Errorf("listed %+v, want only Ops", groups.Groups)
+	}
+
+	if _, err := client.Groups.Delete(ctx, other.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Groups.Delete(ctx, other.ID); err == nil {
+		t.Error("deleting a deleted group succeeded")
+	}
+}
+
+func TestSyncGroups(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	jane := srv.AddUser(team, clickuptest.User{Username: "Jane Doe"})
+	john := srv.AddUser(team, clickuptest.User{Username: "John Roe"})
+	ctx := context.Background()
+
+	for name, members := range map[string][]int64{"Design": {1, jane}, "Legacy": {john}} {
+		if _, _, err := client.Groups.Create(ctx, team, name, "", members); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	desired := map[string][]int64{"Design": {jane, john}, "Ops": {1}}
+	result, _, err := client.Groups.SyncGroups(ctx, team, desired, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Created) != 1 || result.Created[0].Name != "Ops" {
+		t.Errorf("created %+v, want Ops", result.Created)
+	}
+	if len(result.Updated) != 1 || result.Updated[0].Name != "Design" {
+		t.Errorf("updated %+v, want Design", result.Updated)
+	}
+	if len(result.Deleted) != 1 || result.Deleted[0].Name != "Legacy" {
+		t.Errorf("deleted %+v, want Legacy", result.Deleted)
+	}
+
+	groups, _, err := client.Groups.List(ctx, team)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, g := range groups.Groups {
+		want := desired[g.Name]
+		sort.Slice(want, func(i, j int) bool { return want[i] < want[j] })
+		if got := memberIDs(g); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("members of %s = %v, want %v", g.Name, got, want)
+		}
+	}
+
+	// Syncing again changes nothing.
+	result, _, err = client.Groups.SyncGroups(ctx, team, desired, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Created)+len(result.Updated)+len(result.Deleted) != 0 {
+		t.Errorf("second sync = %+v", result)
+	}
+}
@@ -0,0 +1,174 @@
+package clickup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type GuestsService service
+
+// Permission is the access level a guest is given on a task, list or folder.
+type Permission string
+
+const (
+	PermissionRead    Permission = "read"
+	PermissionComment Permission = "comment"
+	PermissionEdit    Permission = "edit"
+	PermissionCreate  Permission = "create"
+)
+
+type GuestWrapper struct {
+	Guest Guest `json:"guest"`
+}
+
+type Guest struct {
+	User struct {
+		ID             int64  `json:"id"`
+		Username       string `json:"username"`
+		Email          string `json:"email"`
+		Color          string `json:"color"`
+		ProfilePicture string `json:"profilePicture"`
+		Initials       string `json:"initials"`
+		Role           Role   `json:"role"`
+		CustomRole     *struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"custom_role"`
+		LastActive  string `json:"last_active"`
+		DateJoined  string `json:"date_joined"`
+		DateInvited string `json:"date_invited"`
+	} `json:"user"`
+	InvitedBy struct {
+		ID             int64  `json:"id"`
+		Username       string `json:"username"`
+		Color          string `json:"color"`
+		Email          string `json:"email"`
+		Initials       string `json:"initials"`
+		ProfilePicture string `json:"profilePicture"`
+	} `json:"invited_by"`
+	CanSeeTimeSpent     bool `json:"can_see_time_spent"`
+	CanSeeTimeEstimated bool `json:"can_see_time_estimated"`
+	CanEditTags         bool `json:"can_edit_tags"`
+	CanCreateViews      bool `json:"can_create_views"`
+	Shared              struct {
+		Tasks   []interface{} `json:"tasks"`
+		Lists   []interface{} `json:"lists"`
+		Folders []interface{} `json:"folders"`
+	} `json:"shared"`
+}
+
+// GuestRequest is the body used to invite and edit guests. Email is only
+// used by Invite and Username only by Edit; nil permissions are left
+// unchanged.
+type GuestRequest struct {
+	Email               string `json:"email,omitempty"`
+	Username            string `json:"username,omitempty"`
+	CanEditTags         *bool  `json:"can_edit_tags,omitempty"`
+	CanSeeTimeSpent     *bool  `json:"can_see_time_spent,omitempty"`
+	CanSeeTimeEstimated *bool  `json:"can_see_time_estimated,omitempty"`
+	CanCreateViews      *bool  `json:"can_create_views,omitempty"`
+	CustomRoleID        int64  `json:"custom_role_id,omitempty"`
+}
+
+type guestPermissionRequest struct {
+	PermissionLevel Permission `json:"permission_level"`
+}
+
+// Invite invites a guest to the workspace. The returned workspace lists the
+// members including the invited guest.
+func (s *GuestsService) Invite(ctx context.Context, teamID string, guest *GuestRequest) (*Workspace, *Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("team/%s/guest", teamID), guest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(WorkspaceWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &wResp.Workspace, resp, nil
+}
+
+func (s *GuestsService) Get(ctx context.Context, teamID string, guestID int64) (*Guest, *Response, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("team/%s/guest/%d", teamID, guestID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doGuest(ctx, req)
+}
+
+func (s *GuestsService) Edit(ctx context.Context, teamID string, guestID int64, guest *GuestRequest) (*Guest, *Response, error) {
+	req, err := s.client.NewRequest("PUT", fmt.Sprintf("team/%s/guest/%d", teamID, guestID), guest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doGuest(ctx, req)
+}
+
+func (s *GuestsService) Remove(ctx context.Context, teamID string, guestID int64) (*Workspace, *Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("team/%s/guest/%d", teamID, guestID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(WorkspaceWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &wResp.Workspace, resp, nil
+}
+
+func (s *GuestsService) AddToTask(ctx context.Context, taskID string, guestID int64, level Permission, query string) (*Guest, *Response, error) {
+	return s.share(ctx, "POST", fmt.Sprintf("task/%s/guest/%d%s", taskID, guestID, query), level)
+}
+
+func (s *GuestsService) RemoveFromTask(ctx context.Context, taskID string, guestID int64, query string) (*Guest, *Response, error) {
+	return s.share(ctx, "DELETE", fmt.Sprintf("task/%s/guest/%d%s", taskID, guestID, query), "")
+}
+
+func (s *GuestsService) AddToList(ctx context.Context, listID string, guestID int64, level Permission, query string) (*Guest, *Response, error) {
+	return s.share(ctx, "POST", fmt.Sprintf("list/%s/guest/%d%s", listID, guestID, query), level)
+}
+
+func (s *GuestsService) RemoveFromList(ctx context.Context, listID string, guestID int64, query string) (*Guest, *Response, error) {
+	return s.share(ctx, "DELETE", fmt.Sprintf("list/%s/guest/%d%s", listID, guestID, query), "")
+}
+
+func (s *GuestsService) AddToFolder(ctx context.Context, folderID string, guestID int64, level Permission, query string) (*Guest, *Response, error) {
+	return s.share(ctx, "POST", fmt.Sprintf("folder/%s/guest/%d%s", folderID, guestID, query), level)
+}
+
+func (s *GuestsService) RemoveFromFolder(ctx context.Context, folderID string, guestID int64, query string) (*Guest, *Response, error) {
+	return s.share(ctx, "DELETE", fmt.Sprintf("folder/%s/guest/%d%s", folderID, guestID, query), "")
+}
+
+// share adds (with a permission level) or removes a guest on an object.
+func (s *GuestsService) share(ctx context.Context, method string, urlStr string, level Permission) (*Guest, *Response, error) {
+	var body interface{}
+	if level != "" {
+		body = &guestPermissionRequest{PermissionLevel: level}
+	}
+	req, err := s.client.NewRequest(method, urlStr, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doGuest(ctx, req)
+}
+
+func (s *GuestsService) doGuest(ctx context.Context, req *http.Request) (*Guest, *Response, error) {
+	wResp := new(GuestWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &wResp.Guest, resp, nil
+}
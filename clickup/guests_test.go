@@ -0,0 +1,84 @@
+package clickup_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+func TestGuests(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	space := srv.AddSpace(team, "Engineering")
+	folder := srv.AddFolder(space, "Q1")
+	list := srv.AddList(space, folder, "Backlog")
+	ctx := context.Background()
+	yes := true
+
+	ws, _, err := client.Guests.Invite(ctx, team, &clickup.GuestRequest{Email: "guest@example.com", CanSeeTimeSpent: &yes})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var guestID int64
+	for _, m := range ws.Members {
+		if m.User.Email == "guest@example.com" {
+			guestID = m.User.ID
+			if m.User.Role != clickup.RoleGuest {
+				t.Errorf("invited guest has role %v", m.User.Role)
+			}
+		}
+	}
+	if guestID == 0 {
+		t.Fatalf("invited guest is not a member: %+v", ws.Members)
+	}
+	if _, _, err := client.Guests.Invite(ctx, team, &clickup.GuestRequest{Email: "GUEST@example.com"}); err == nil {
+		t.Error("inviting a member again succeeded")
+	}
+
+	g, _, err := client.Guests.Edit(ctx, team, guestID, &clickup.GuestRequest{Username: "Visitor", CanEditTags: &yes})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.User.Username != "Visitor" || !g.CanEditTags || !g.CanSeeTimeSpent || g.CanCreateViews || g.InvitedBy.ID != 1 {
+		t.Errorf("edited guest = %+v", g)
+	}
+
+	if g, _, err = client.Guests.AddToList(ctx, list, guestID, clickup.PermissionEdit, ""); err != nil {
+		t.Fatal(err)
+	}
+	if g, _, err = client.Guests.AddToFolder(ctx, folder, guestID, clickup.PermissionRead, ""); err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Shared.Lists) != 1 || len(g.Shared.Folders) != 1 || len(g.Shared.Tasks) != 0 {
+		t.Errorf("shared = %+v", g.Shared)
+	}
+	if _, _, err := client.Guests.AddToList(ctx, list, guestID, "owner", ""); err == nil {
+		t.Error("sharing with an invalid permission level succeeded")
+	}
+	if g, _, err = client.Guests.RemoveFromList(ctx, list, guestID, ""); err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Shared.Lists) != 0 {
+		t.Errorf("shared lists after removal = %+v", g.Shared.Lists)
+	}
+
+	g, _, err = client.Guests.Get(ctx, team, guestID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.User.ID != guestID || g.User.Role != clickup.RoleGuest || len(g.Shared.Folders) != 1 {
+		t.Errorf("guest = %+v", g)
+	}
+
+	ws, _, err = client.Guests.Remove(ctx, team, guestID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ws.Members) != 1 {
+		t.Errorf("workspace has %d members after removing the guest, want 1", len(ws.Members))
+	}
+	if _, _, err := client.Guests.Get(ctx, team, guestID); err == nil {
+		t.Error("getting a removed guest succeeded")
+	}
+}
@@ -0,0 +1,270 @@
+package hierarchy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangeKind is the kind of a change between two snapshots.
+type ChangeKind string
+
+const (
+	Added   ChangeKind = "added"
+	Removed ChangeKind = "removed"
+	Renamed ChangeKind = "renamed"
+	// Moved is reported for a folder moved to another space, or a list moved
+	// to another folder or space.
+	Moved ChangeKind = "moved"
+	// StatusesChanged is reported for a space, or a list with statuses of its
+	// own, whose statuses changed.
+	StatusesChanged ChangeKind = "statuses changed"
+)
+
+// Object is the type of an object of a snapshot.
+type Object string
+
+const (
+	WorkspaceObject Object = "workspace"
+	SpaceObject     Object = "space"
+	FolderObject    Object = "folder"
+	ListObject      Object = "list"
+	ViewObject      Object = "view"
+	FieldObject     Object = "field"
+)
+
+// Ref identifies an object of a snapshot.
+type Ref struct {
+	Object Object `json:"object"`
+	ID     string `json:"id"`
+	Name   string `json:"name,omitempty"`
+}
+
+func (r Ref) String() string {
+	if r.Name == "" {
+		return fmt.Sprintf("%s %s", r.Object, r.ID)
+	}
+	return fmt.Sprintf("%s %q (%s)", r.Object, r.Name, r.ID)
+}
+
+// Change is a difference between two snapshots.
+type Change struct {
+	Kind ChangeKind `json:"kind"`
+	// Object is the object changed, named as in the new snapshot, or as in
+	// the old one if it was removed.
+	Object Ref `json:"object"`
+	// Parent is the parent of the object in the new snapshot, or in the old
+	// one if it was removed. The parent of a field is the list it was found
+	// in: a field accessible from several lists is compared list by list.
+	Parent Ref `json:"parent"`
+	// OldName is the previous name of a renamed object.
+	OldName string `json:"old_name,omitempty"`
+	// OldParent is the previous parent of a moved object.
+	OldParent *Ref `json:"old_parent,omitempty"`
+	// Statuses is how the statuses changed, for StatusesChanged.
+	Statuses *StatusDiff `json:"statuses,omitempty"`
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("added %v to %v", c.Object, c.Parent)
+	case Removed:
+		return fmt.Sprintf("removed %v from %v", c.Object, c.Parent)
+	case Renamed:
+		return fmt.Sprintf("renamed %s %q (%s) to %q", c.Object.Object, c.OldName, c.Object.ID, c.Object.Name)
+	case Moved:
+		return fmt.Sprintf("moved %v from %v to %v", c.Object, c.OldParent, c.Parent)
+	case StatusesChanged:
+		return fmt.Sprintf("changed statuses of %v: %v", c.Object, c.Statuses)
+	}
+	return fmt.Sprintf("%s %v", c.Kind, c.Object)
+}
+
+// StatusDiff is how a workflow changed. Statuses are matched by name, so a
+// renamed status is reported as removed and added.
+type StatusDiff struct {
+	Added   []StatusSnapshot `json:"added,omitempty"`
+	Removed []StatusSnapshot `json:"removed,omitempty"`
+	// Changed are the statuses whose type or color changed, as they are in
+	// the new snapshot.
+	Changed []StatusSnapshot `json:"changed,omitempty"`
+	// Reordered is set when the statuses kept are in another order.
+	Reordered bool `json:"reordered,omitempty"`
+}
+
+func (d *StatusDiff) String() string {
+	var parts []string
+	for _, st := range d.Added {
+		parts = append(parts, fmt.Sprintf("added %q", st.Status))
+	}
+	for _, st := range d.Removed {
+		parts = append(parts, fmt.Sprintf("removed %q", st.Status))
+	}
+	for _, st := range d.Changed {
+		parts = append(parts, fmt.Sprintf("changed %q", st.Status))
+	}
+	if d.Reordered {
+		parts = append(parts, "reordered")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Diff returns the changes from the snapshot from to the snapshot to, sorted
+// by type of object, then by ID. Objects added or removed along with their
+// parent, such as the lists of a removed folder, are not reported on their
+// own.
+func Diff(from, to *Snapshot) []Change {
+	before, after := from.nodes(), to.nodes()
+	// only reports whether the object is in nodes but not in others.
+	only := func(r Ref, nodes, others map[string]*node) bool {
+		return nodes[r.key()] != nil && others[r.key()] == nil
+	}
+
+	var changes []Change
+	for key, n := range after {
+		o, ok := before[key]
+		if !ok {
+			if only(n.parent, after, before) {
+				continue
+			}
+			changes = append(changes, Change{Kind: Added, Object: n.ref, Parent: n.parent})
+			continue
+		}
+		if o.ref.Name != n.ref.Name {
+			changes = append(changes, Change{Kind: Renamed, Object: n.ref, Parent: n.parent, OldName: o.ref.Name})
+		}
+		if o.parent.Object != n.parent.Object || o.parent.ID != n.parent.ID {
+			oldParent := o.parent
+			changes = append(changes, Change{Kind: Moved, Object: n.ref, Parent: n.parent, OldParent: &oldParent})
+		}
+		if o.ownStatuses || n.ownStatuses {
+			if d := diffStatuses(o.statuses, n.statuses); d != nil {
+				changes = append(changes, Change{Kind: StatusesChanged, Object: n.ref, Parent: n.parent, Statuses: d})
+			}
+		}
+	}
+	for key, o := range before {
+		if after[key] != nil || only(o.parent, before, after) {
+			continue
+		}
+		changes = append(changes, Change{Kind: Removed, Object: o.ref, Parent: o.parent})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if ra, rb := objectRank[a.Object.Object], objectRank[b.Object.Object]; ra != rb {
+			return ra < rb
+		}
+		if a.Object.ID != b.Object.ID {
+			return a.Object.ID < b.Object.ID
+		}
+		if a.Parent.ID != b.Parent.ID {
+			return a.Parent.ID < b.Parent.ID
+		}
+		return kindRank[a.Kind] < kindRank[b.Kind]
+	})
+	return changes
+}
+
+var objectRank = map[Object]int{SpaceObject: 0, FolderObject: 1, ListObject: 2, ViewObject: 3, FieldObject: 4}
+
+var kindRank = map[ChangeKind]int{Added: 0, Removed: 1, Renamed: 2, Moved: 3, StatusesChanged: 4}
+
+// node is an object of a snapshot, flattened for comparison.
+type node struct {
+	ref    Ref
+	parent Ref
+	// ownStatuses is set for the spaces and the lists that have statuses of
+	// their own, whose statuses are compared.
+	ownStatuses bool
+	statuses    []StatusSnapshot
+}
+
+// key identifies the object in a snapshot.
+func (r Ref) key() string {
+	return string(r.Object) + "/" + r.ID
+}
+
+// nodes returns the objects of the snapshot by key. Fields are keyed by list
+// too, since a field may be accessible from several lists.
+func (s *Snapshot) nodes() map[string]*node {
+	nodes := make(map[string]*node)
+	add := func(n *node) {
+		key := n.ref.key()
+		if n.ref.Object == FieldObject {
+			key = n.parent.key() + "/" + key
+		}
+		nodes[key] = n
+	}
+	addViews := func(views []ViewSnapshot, parent Ref) {
+		for _, v := range views {
+			add(&node{ref: Ref{ViewObject, v.ID, v.Name}, parent: parent})
+		}
+	}
+	addLists := func(lists []ListSnapshot, parent Ref) {
+		for _, l := range lists {
+			ref := Ref{ListObject, l.ID, l.Name}
+			add(&node{ref: ref, parent: parent, ownStatuses: l.OverrideStatuses, statuses: l.Statuses})
+			for _, f := range l.Fields {
+				add(&node{ref: Ref{FieldObject, f.ID, f.Name}, parent: ref})
+			}
+			addViews(l.Views, ref)
+		}
+	}
+
+	workspace := Ref{Object: WorkspaceObject, ID: s.WorkspaceID}
+	addViews(s.Views, workspace)
+	for _, sp := range s.Spaces {
+		space := Ref{SpaceObject, sp.ID, sp.Name}
+		add(&node{ref: space, parent: workspace, ownStatuses: true, statuses: sp.Statuses})
+		addViews(sp.Views, space)
+		for _, f := range sp.Folders {
+			folder := Ref{FolderObject, f.ID, f.Name}
+			add(&node{ref: folder, parent: space})
+			addViews(f.Views, folder)
+			addLists(f.Lists, folder)
+		}
+		addLists(sp.Lists, space)
+	}
+	return nodes
+}
+
+// diffStatuses returns how the workflow old changed into new, or nil if it
+// did not.
+func diffStatuses(old, new []StatusSnapshot) *StatusDiff {
+	var d StatusDiff
+	before := make(map[string]StatusSnapshot, len(old))
+	for _, st := range old {
+		before[st.Status] = st
+	}
+	after := make(map[string]bool, len(new))
+	var kept []string
+	for _, st := range new {
+		after[st.Status] = true
+		o, ok := before[st.Status]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, st)
+			continue
+		case o != st:
+			d.Changed = append(d.Changed, st)
+		}
+		kept = append(kept, st.Status)
+	}
+	i := 0
+	for _, st := range old {
+		if !after[st.Status] {
+			d.Removed = append(d.Removed, st)
+			continue
+		}
+		if i >= len(kept) || kept[i] != st.Status {
+			d.Reordered = true
+		}
+		i++
+	}
+	if d.Added == nil && d.Removed == nil && d.Changed == nil && !d.Reordered {
+		return nil
+	}
+	return &d
+}
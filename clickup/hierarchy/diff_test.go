@@ -0,0 +1,76 @@
+package hierarchy
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	var from, to Snapshot
+	if err := json.Unmarshal([]byte(`{
+		"workspace_id": "1",
+		"spaces": [{
+			"id": "s1", "name": "Engineering",
+			"statuses": [
+				{"status": "open", "type": "open", "color": "#ccc"},
+				{"status": "in progress", "type": "custom"},
+				{"status": "done", "type": "closed", "color": "#0f0"}
+			],
+			"folders": [{
+				"id": "f1", "name": "Sprints",
+				"lists": [{"id": "l1", "name": "Sprint 1"}]
+			}],
+			"lists": [
+				{"id": "l2", "name": "Backlog", "override_statuses": true, "statuses": [
+					{"status": "new", "type": "open"},
+					{"status": "triaged", "type": "custom"}
+				]},
+				{"id": "l3", "name": "Bugs", "statuses": [{"status": "open", "type": "open"}]}
+			]
+		}]
+	}`), &from); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`{
+		"workspace_id": "1",
+		"spaces": [{
+			"id": "s1", "name": "Engineering",
+			"statuses": [
+				{"status": "open", "type": "open", "color": "#ccc"},
+				{"status": "review", "type": "custom"},
+				{"status": "done", "type": "closed", "color": "#00f"}
+			],
+			"folders": [{"id": "f1", "name": "Iterations"}],
+			"lists": [
+				{"id": "l1", "name": "Sprint 1"},
+				{"id": "l2", "name": "Backlog", "override_statuses": true, "statuses": [
+					{"status": "triaged", "type": "custom"},
+					{"status": "new", "type": "open"}
+				]},
+				{"id": "l3", "name": "Bugs", "statuses": [{"status": "to do", "type": "open"}]}
+			]
+		}]
+	}`), &to); err != nil {
+		t.Fatal(err)
+	}
+
+	changes := Diff(&from, &to)
+	want := []string{
+		`changed statuses of space "Engineering" (s1): added "review", removed "in progress", changed "done"`,
+		`renamed folder "Sprints" (f1) to "Iterations"`,
+		`moved list "Sprint 1" (l1) from folder "Sprints" (f1) to space "Engineering" (s1)`,
+		`changed statuses of list "Backlog" (l2): reordered`,
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("Diff = %v, want %d changes", changes, len(want))
+	}
+	for i, c := range changes {
+		if got := c.String(); got != want[i] {
+			t.Errorf("change %d = %s, want %s", i, got, want[i])
+		}
+	}
+
+	if changes := Diff(&to, &to); len(changes) != 0 {
+		t.Errorf("Diff of a snapshot with itself = %v", changes)
+	}
+}
@@ -0,0 +1,282 @@
+package hierarchy
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Snapshot is the structure of a workspace at one time: its spaces, folders
+// and lists with their statuses, custom fields and views, but no tasks. It is
+// meant to be kept as JSON and compared with a later one by Diff.
+//
+// Objects are sorted by ID and statuses in workflow order, so the JSON
+// encodings of two snapshots of the same structure differ only in Taken.
+type Snapshot struct {
+	WorkspaceID string    `json:"workspace_id"`
+	Taken       time.Time `json:"taken"`
+	// Views are the views of the workspace itself, such as Everything views.
+	Views  []ViewSnapshot  `json:"views,omitempty"`
+	Spaces []SpaceSnapshot `json:"spaces,omitempty"`
+}
+
+// SpaceSnapshot is a space of a Snapshot.
+type SpaceSnapshot struct {
+	ID       string           `json:"id"`
+	Name     string           `json:"name"`
+	Private  bool             `json:"private,omitempty"`
+	Archived bool             `json:"archived,omitempty"`
+	Statuses []StatusSnapshot `json:"statuses,omitempty"`
+	Views    []ViewSnapshot   `json:"views,omitempty"`
+	Folders  []FolderSnapshot `json:"folders,omitempty"`
+	// Lists are the lists of the space that are not in a folder.
+	Lists []ListSnapshot `json:"lists,omitempty"`
+}
+
+// FolderSnapshot is a folder of a Snapshot.
+type FolderSnapshot struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	Archived bool           `json:"archived,omitempty"`
+	Views    []ViewSnapshot `json:"views,omitempty"`
+	Lists    []ListSnapshot `json:"lists,omitempty"`
+}
+
+// ListSnapshot is a list of a Snapshot.
+type ListSnapshot struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Archived bool   `json:"archived,omitempty"`
+	// OverrideStatuses is set when the list has statuses of its own instead
+	// of those of its space.
+	OverrideStatuses bool             `json:"override_statuses,omitempty"`
+	Statuses         []StatusSnapshot `json:"statuses,omitempty"`
+	Fields           []FieldSnapshot  `json:"fields,omitempty"`
+	Views            []ViewSnapshot   `json:"views,omitempty"`
+}
+
+// StatusSnapshot is a status of a workflow. Its position in the workflow is
+// its index in the statuses of the space or list.
+type StatusSnapshot struct {
+	Status string `json:"status"`
+	Type   string `json:"type"`
+	Color  string `json:"color,omitempty"`
+}
+
+// FieldSnapshot is a custom field accessible from a list.
+type FieldSnapshot struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+}
+
+// ViewSnapshot is a view of a workspace, space, folder or list.
+type ViewSnapshot struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Snapshot walks the hierarchy as Walk does, then fetches the views of every
+// item and the custom fields of every list, and returns the snapshot of the
+// structure walked. The Filter applies, so an archived item is only part of
+// the snapshot if Filter.Archived is set.
+//
+// If anything could not be fetched, Snapshot returns no snapshot, since a
+// partial one would differ from the next, and calling it again resumes the
+// walk and fetches the views and fields again. A Walker keeps the tree it
+// walked, so a later snapshot of the workspace needs a new Walker.
+func (w *Walker) Snapshot(ctx context.Context) (*Snapshot, error) {
+	tree, err := w.Walk(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &Snapshot{WorkspaceID: w.workspaceID, Taken: w.now().UTC()}
+	reqs := []request{viewsRequest("team/"+w.workspaceID, w.client.Workspaces.Views, w.workspaceID, &snap.Views)}
+	// The snapshots are not moved until the requests are done, so that the
+	// requests can store their results in them.
+	snap.Spaces = make([]SpaceSnapshot, len(tree.Spaces))
+	for i, s := range tree.Spaces {
+		ss := &snap.Spaces[i]
+		*ss = newSpaceSnapshot(s)
+		reqs = append(reqs, viewsRequest("space/"+s.ID, w.client.Spaces.Views, s.ID, &ss.Views))
+		ss.Folders = make([]FolderSnapshot, len(s.Folders))
+		for j, f := range s.Folders {
+			fs := &ss.Folders[j]
+			*fs = FolderSnapshot{ID: f.ID, Name: f.Name, Archived: f.Archived}
+			reqs = append(reqs, viewsRequest("folder/"+f.ID, w.client.Folders.Views, f.ID, &fs.Views))
+			fs.Lists, reqs = w.listSnapshots(f.Lists, reqs)
+		}
+		ss.Lists, reqs = w.listSnapshots(s.Lists, reqs)
+	}
+
+	if err := w.do(ctx, reqs); err != nil {
+		return nil, err
+	}
+	snap.sort()
+	return snap, nil
+}
+
+// listSnapshots returns the snapshots of lists, appending the requests for
+// their views and fields to reqs.
+func (w *Walker) listSnapshots(lists []*List, reqs []request) ([]ListSnapshot, []request) {
+	snaps := make([]ListSnapshot, len(lists))
+	for i, l := range lists {
+		ls := &snaps[i]
+		*ls = newListSnapshot(l)
+		reqs = append(reqs, viewsRequest("list/"+l.ID, w.client.Lists.Views, l.ID, &ls.Views))
+		id := l.ID
+		reqs = append(reqs, request{
+			endpoint: "list/" + id + "/field",
+			do: func(ctx context.Context) (*clickup.Response, error) {
+				v, resp, err := w.client.Lists.CustomFields(ctx, id, "")
+				if err == nil {
+					ls.Fields = make([]FieldSnapshot, len(v.Fields))
+					for i, f := range v.Fields {
+						ls.Fields[i] = FieldSnapshot{ID: f.ID, Name: f.Name, Type: f.Type, Required: f.Required}
+					}
+				}
+				return resp, err
+			},
+		})
+	}
+	return snaps, reqs
+}
+
+func newSpaceSnapshot(s *Space) SpaceSnapshot {
+	statuses := make([]orderedStatus, len(s.Statues))
+	for i, st := range s.Statues {
+		statuses[i] = orderedStatus{st.OrderIndex, StatusSnapshot{Status: st.Status, Type: st.Type, Color: st.Color}}
+	}
+	return SpaceSnapshot{
+		ID:       s.ID,
+		Name:     s.Name,
+		Private:  s.Private,
+		Archived: s.Archived,
+		Statuses: workflow(statuses),
+	}
+}
+
+func newListSnapshot(l *List) ListSnapshot {
+	statuses := make([]orderedStatus, len(l.Statuses))
+	for i, st := range l.Statuses {
+		statuses[i] = orderedStatus{st.OrderIndex, StatusSnapshot{Status: st.Status, Type: st.Type, Color: st.Color}}
+	}
+	return ListSnapshot{
+		ID:               l.ID,
+		Name:             l.Name,
+		Archived:         l.Archived,
+		OverrideStatuses: l.OverrideStatuses,
+		Statuses:         workflow(statuses),
+	}
+}
+
+type orderedStatus struct {
+	order int64
+	StatusSnapshot
+}
+
+// workflow returns the statuses in order.
+func workflow(statuses []orderedStatus) []StatusSnapshot {
+	sort.SliceStable(statuses, func(i, j int) bool { return statuses[i].order < statuses[j].order })
+	var out []StatusSnapshot
+	for _, st := range statuses {
+		out = append(out, st.StatusSnapshot)
+	}
+	return out
+}
+
+// request is a request made for a snapshot, which stores its result in the
+// snapshot.
+type request struct {
+	// endpoint is the path requested, as reported in a Failure.
+	endpoint string
+	do       func(ctx context.Context) (*clickup.Response, error)
+}
+
+func viewsRequest(parent string, views func(context.Context, string, string) (*clickup.ViewsWrapper, *clickup.Response, error), id string, dst *[]ViewSnapshot) request {
+	return request{
+		endpoint: parent + "/view",
+		do: func(ctx context.Context) (*clickup.Response, error) {
+			v, resp, err := views(ctx, id, "")
+			if err == nil {
+				*dst = make([]ViewSnapshot, len(v.Views))
+				for i, view := range v.Views {
+					(*dst)[i] = ViewSnapshot{ID: view.ID, Name: view.Name, Type: view.Type}
+				}
+			}
+			return resp, err
+		},
+	}
+}
+
+// do makes the requests, Workers at a time. If some fail, it makes the others
+// and returns an *Error.
+func (w *Walker) do(ctx context.Context, reqs []request) error {
+	var mu sync.Mutex
+	var failures []Failure
+	var wg sync.WaitGroup
+	ch := make(chan request)
+	for i := 0; i < w.workers(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range ch {
+				err := w.call(ctx, func() (*clickup.Response, error) { return r.do(ctx) })
+				if err != nil {
+					mu.Lock()
+					failures = append(failures, Failure{Endpoint: r.endpoint, Err: err})
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, r := range reqs {
+		ch <- r
+	}
+	close(ch)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		sort.Slice(failures, func(i, j int) bool { return failures[i].Endpoint < failures[j].Endpoint })
+		return &Error{Failures: failures}
+	}
+	return nil
+}
+
+// sort puts the snapshot in canonical order.
+func (s *Snapshot) sort() {
+	sortViews(s.Views)
+	sort.Slice(s.Spaces, func(i, j int) bool { return s.Spaces[i].ID < s.Spaces[j].ID })
+	for i := range s.Spaces {
+		sp := &s.Spaces[i]
+		sortViews(sp.Views)
+		sort.Slice(sp.Folders, func(i, j int) bool { return sp.Folders[i].ID < sp.Folders[j].ID })
+		for j := range sp.Folders {
+			sortViews(sp.Folders[j].Views)
+			sortLists(sp.Folders[j].Lists)
+		}
+		sortLists(sp.Lists)
+	}
+}
+
+func sortLists(lists []ListSnapshot) {
+	sort.Slice(lists, func(i, j int) bool { return lists[i].ID < lists[j].ID })
+	for i := range lists {
+		l := &lists[i]
+		sortViews(l.Views)
+		sort.Slice(l.Fields, func(i, j int) bool { return l.Fields[i].ID < l.Fields[j].ID })
+	}
+}
+
+func sortViews(views []ViewSnapshot) {
+	sort.Slice(views, func(i, j int) bool { return views[i].ID < views[j].ID })
+}
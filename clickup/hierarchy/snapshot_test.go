@@ -0,0 +1,109 @@
+package hierarchy
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+func TestSnapshot(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	team := srv.AddWorkspace("Acme")
+	eng := srv.AddSpace(team, "Engineering")
+	sprints := srv.AddFolder(eng, "Sprints")
+	sprint := srv.AddList(eng, sprints, "Sprint 1")
+	backlog := srv.AddList(eng, "", "Backlog")
+	marketing := srv.AddSpace(team, "Marketing")
+	srv.AddView(team, clickuptest.View{Name: "Everything", Type: "list"})
+	srv.AddView(sprint, clickuptest.View{Name: "Board", Type: "board"})
+	srv.AddCustomField(backlog, clickuptest.CustomField{Name: "Points", Type: "number", Required: true})
+	client := srv.Client()
+	ctx := context.Background()
+
+	snap, err := NewWalker(client, team).Snapshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snap.Spaces) != 2 || len(snap.Views) != 1 {
+		t.Fatalf("snapshot has %d spaces, %d views", len(snap.Spaces), len(snap.Views))
+	}
+	space := snap.Spaces[0]
+	if space.ID != eng {
+		space = snap.Spaces[1]
+	}
+	if len(space.Statuses) != len(clickuptest.DefaultStatuses) || space.Statuses[0].Status != "to do" {
+		t.Errorf("statuses of %s = %v", space.Name, space.Statuses)
+	}
+	if len(space.Folders) != 1 || len(space.Folders[0].Lists) != 1 || len(space.Lists) != 1 {
+		t.Fatalf("%s has %d folders, %d folderless lists", space.Name, len(space.Folders), len(space.Lists))
+	}
+	if views := space.Folders[0].Lists[0].Views; len(views) != 1 || views[0].Type != "board" {
+		t.Errorf("views of %s = %v", space.Folders[0].Lists[0].Name, views)
+	}
+	if fields := space.Lists[0].Fields; len(fields) != 1 || fields[0].Name != "Points" || !fields[0].Required {
+		t.Errorf("fields of %s = %v", space.Lists[0].Name, fields)
+	}
+
+	// The encoding of an unchanged workspace does not change.
+	again, err := NewWalker(client, team).Snapshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again.Taken = snap.Taken
+	b1, _ := json.Marshal(snap)
+	b2, _ := json.Marshal(again)
+	if string(b1) != string(b2) {
+		t.Errorf("snapshots of the same workspace differ:\n%s\n%s", b1, b2)
+	}
+	if changes := Diff(snap, again); len(changes) != 0 {
+		t.Errorf("Diff of the same workspace = %v", changes)
+	}
+
+	do(t, srv, "PUT", "list/"+backlog, map[string]interface{}{"name": "Icebox"})
+	do(t, srv, "DELETE", "folder/"+sprints, nil)
+	roadmap := srv.AddList(marketing, "", "Roadmap")
+	srv.AddView(roadmap, clickuptest.View{Name: "Timeline", Type: "timeline"})
+	srv.AddCustomField(backlog, clickuptest.CustomField{Name: "Estimate", Type: "number"})
+
+	later, err := NewWalker(client, team).Snapshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range Diff(snap, later) {
+		got = append(got, string(c.Kind)+" "+string(c.Object.Object)+" "+c.Object.Name)
+	}
+	want := []string{
+		"removed folder Sprints",
+		"added list Roadmap",
+		"renamed list Icebox",
+		"added field Estimate",
+	}
+	sort.Strings(got)
+	sort.Strings(want)
+	if len(got) != len(want) {
+		t.Fatalf("Diff = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Diff = %q, want %q", got, want)
+			break
+		}
+	}
+}
+
+func do(t *testing.T, srv *clickuptest.Server, method, path string, body interface{}) {
+	t.Helper()
+	c := srv.Client()
+	req, err := c.NewRequest(method, path, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+}
@@ -0,0 +1,86 @@
+// Package hierarchy crawls the spaces, folders, lists and tasks of a ClickUp
+// workspace into a Tree, calling back for every item found:
+//
+//	w := hierarchy.NewWalker(client, teamID)
+//	w.Workers = 8
+//	w.Filter.Lists = []string{"Sprint *"}
+//	w.Tasks = true
+//	tree, err := w.Walk(ctx)
+//	if err != nil {
+//		// Walk again to retry what failed.
+//		tree, err = w.Walk(ctx)
+//	}
+//
+// Walker.Snapshot records the structure of the workspace, with the statuses,
+// custom fields and views of its items, as a Snapshot to keep as JSON, and
+// Diff reports what changed between two snapshots:
+//
+//	snap, err := hierarchy.NewWalker(client, teamID).Snapshot(ctx)
+//	...
+//	for _, c := range hierarchy.Diff(previous, snap) {
+//		log.Print(c)
+//	}
+package hierarchy
+
+import "github.com/catdevman/go-clickup/clickup"
+
+// Tree is the hierarchy of a workspace. Every node points back to its
+// parents, so a Tree cannot be encoded as JSON.
+type Tree struct {
+	WorkspaceID string
+	Spaces      []*Space
+}
+
+// Space is a space with its folders and folderless lists.
+type Space struct {
+	clickup.Space
+	Folders []*Folder
+	// Lists are the lists of the space that are not in a folder.
+	Lists []*List
+}
+
+// Folder is a folder with its lists.
+type Folder struct {
+	clickup.Folder
+	Space *Space
+	Lists []*List
+}
+
+// List is a list with its tasks, which are only fetched when Walker.Tasks is
+// set.
+type List struct {
+	clickup.List
+	Space *Space
+	// Folder is nil for a folderless list.
+	Folder *Folder
+	Tasks  []*Task
+}
+
+// Task is a task of a list.
+type Task struct {
+	clickup.Task
+	List *List
+}
+
+// Lists returns every list of the tree, the lists of each space's folders
+// first, then its folderless lists.
+func (t *Tree) Lists() []*List {
+	var lists []*List
+	for _, s := range t.Spaces {
+		for _, f := range s.Folders {
+			lists = append(lists, f.Lists...)
+		}
+		lists = append(lists, s.Lists...)
+	}
+	return lists
+}
+
+// List returns the list with the given ID, or nil if the tree has none.
+func (t *Tree) List(id string) *List {
+	for _, l := range t.Lists() {
+		if l.ID == id {
+			return l
+		}
+	}
+	return nil
+}
@@ -0,0 +1,565 @@
+package hierarchy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// SkipChildren is returned by the OnSpace, OnFolder and OnList callbacks to
+// leave the children of an item out of the walk. The item itself is kept in
+// the tree.
+var SkipChildren = errors.New("skip children")
+
+// Filter selects the parts of the hierarchy a Walker fetches.
+type Filter struct {
+	// Archived includes archived spaces, folders, lists and tasks, which
+	// takes a second request per listing.
+	Archived bool
+
+	// SpaceIDs restricts the walk to these spaces if not empty.
+	SpaceIDs []string
+	// ExcludeSpaceIDs leaves these spaces out.
+	ExcludeSpaceIDs []string
+
+	// Lists restricts the walk to the lists whose name matches one of these
+	// patterns, in the syntax of path.Match, if not empty.
+	Lists []string
+	// ExcludeLists leaves out the lists whose name matches one of these
+	// patterns.
+	ExcludeLists []string
+}
+
+func (f *Filter) space(s clickup.Space) bool {
+	if len(f.SpaceIDs) > 0 && !contains(f.SpaceIDs, s.ID) {
+		return false
+	}
+	return !contains(f.ExcludeSpaceIDs, s.ID)
+}
+
+func (f *Filter) list(l clickup.List) bool {
+	if len(f.Lists) > 0 && !match(f.Lists, l.Name) {
+		return false
+	}
+	return !match(f.ExcludeLists, l.Name)
+}
+
+func (f *Filter) validate() error {
+	for _, patterns := range [][]string{f.Lists, f.ExcludeLists} {
+		for _, p := range patterns {
+			if !validPattern(p) {
+				return fmt.Errorf("hierarchy: list pattern %q: %w", p, path.ErrBadPattern)
+			}
+		}
+	}
+	return nil
+}
+
+// Failure is a listing a walk could not fetch.
+type Failure struct {
+	// Endpoint is the listing that failed, for example "space/790/folder".
+	Endpoint string
+	Err      error
+}
+
+// Error reports the listings a walk could not fetch. The rest of the
+// hierarchy was walked; walking again retries the failed listings.
+type Error struct {
+	Failures []Failure
+}
+
+func (e *Error) Error() string {
+	f := e.Failures[0]
+	if len(e.Failures) == 1 {
+		return fmt.Sprintf("hierarchy: %s: %v", f.Endpoint, f.Err)
+	}
+	return fmt.Sprintf("hierarchy: %d listings failed, first %s: %v", len(e.Failures), f.Endpoint, f.Err)
+}
+
+// Walker crawls the hierarchy of a workspace with a bounded number of
+// concurrent requests.
+//
+// Before each request it checks the rate limit reported by the client, and
+// once fewer than MinRemaining requests remain it waits for the limit to
+// reset. Requests rejected with a *clickup.RateLimitError are retried after
+// the reset.
+//
+// A Walker builds the tree as it goes and remembers what it has fetched, so
+// that when Walk returns an error, calling it again picks up where it
+// stopped: the listings that failed or were not reached are fetched, and the
+// others are not fetched again.
+type Walker struct {
+	// Workers is the maximum number of requests in flight. It defaults to 4.
+	Workers int
+
+	// MinRemaining is the number of requests left in the rate limit window
+	// below which requests wait for the window to reset. It defaults to
+	// Workers.
+	MinRemaining int
+
+	Filter Filter
+
+	// Tasks fetches the tasks of every list, with the filters of TaskQuery in
+	// the form accepted by Tasks.List, which must not set page or archived.
+	Tasks     bool
+	TaskQuery string
+
+	// The callbacks are called for every item found, one at a time, parents
+	// before their children, with the parents of the item set. An error other
+	// than SkipChildren stops the walk and is returned by Walk; the items of
+	// the listing being processed are then handed to the callbacks again by
+	// the next Walk.
+	OnSpace  func(*Space) error
+	OnFolder func(*Folder) error
+	OnList   func(*List) error
+	OnTask   func(*Task) error
+
+	client      *clickup.Client
+	workspaceID string
+
+	tree    *Tree
+	pending []job
+
+	// now and sleep are replaced in tests.
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewWalker returns a Walker of the hierarchy of the workspace.
+func NewWalker(c *clickup.Client, workspaceID string) *Walker {
+	return &Walker{
+		client:      c,
+		workspaceID: workspaceID,
+		now:         time.Now,
+		sleep:       sleep,
+	}
+}
+
+type jobKind int
+
+const (
+	spacesJob jobKind = iota
+	foldersJob
+	folderlessJob
+	listsJob
+	tasksJob
+)
+
+// job is a listing to fetch. Its items are the children of one node.
+type job struct {
+	kind   jobKind
+	space  *Space
+	folder *Folder
+	list   *List
+}
+
+func (j job) endpoint(workspaceID string) string {
+	switch j.kind {
+	case spacesJob:
+		return "team/" + workspaceID + "/space"
+	case foldersJob:
+		return "space/" + j.space.ID + "/folder"
+	case folderlessJob:
+		return "space/" + j.space.ID + "/list"
+	case listsJob:
+		return "folder/" + j.folder.ID + "/list"
+	default:
+		return "list/" + j.list.ID + "/task"
+	}
+}
+
+// result is the outcome of a job.
+type result struct {
+	job     job
+	spaces  []clickup.Space
+	folders []clickup.Folder
+	lists   []clickup.List
+	tasks   []clickup.Task
+	err     error
+}
+
+// Walk crawls the hierarchy, or the part of it a previous Walk did not
+// reach, and returns the tree. If some listings could not be fetched, the
+// tree holds everything else and the error is an *Error.
+func (w *Walker) Walk(ctx context.Context) (*Tree, error) {
+	if err := w.Filter.validate(); err != nil {
+		return nil, err
+	}
+	if w.tree == nil {
+		w.tree = &Tree{WorkspaceID: w.workspaceID}
+		w.pending = []job{{kind: spacesJob}}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan job)
+	results := make(chan result)
+	for i := 0; i < w.workers(); i++ {
+		go func() {
+			for j := range jobs {
+				results <- w.fetch(ctx, j)
+			}
+		}()
+	}
+
+	queue := w.pending
+	w.pending = nil
+	var failures []Failure
+	var stopErr error
+	inflight := 0
+	done := ctx.Done()
+	for inflight > 0 || (len(queue) > 0 && stopErr == nil) {
+		var send chan job
+		var next job
+		if len(queue) > 0 && stopErr == nil {
+			send, next = jobs, queue[0]
+		}
+		select {
+		case send <- next:
+			queue = queue[1:]
+			inflight++
+		case r := <-results:
+			inflight--
+			switch {
+			case stopErr != nil:
+				w.pending = append(w.pending, r.job)
+			case r.err != nil:
+				w.pending = append(w.pending, r.job)
+				if ctx.Err() == nil {
+					failures = append(failures, Failure{Endpoint: r.job.endpoint(w.workspaceID), Err: r.err})
+				}
+			default:
+				children, err := w.handle(r)
+				if err != nil {
+					stopErr = err
+					cancel()
+					w.pending = append(w.pending, r.job)
+					break
+				}
+				queue = append(queue, children...)
+			}
+		case <-done:
+			done = nil
+			if stopErr == nil {
+				stopErr = ctx.Err()
+			}
+		}
+	}
+	close(jobs)
+	w.pending = append(w.pending, queue...)
+
+	switch {
+	case stopErr != nil:
+		return w.tree, stopErr
+	case len(failures) > 0:
+		return w.tree, &Error{Failures: failures}
+	}
+	return w.tree, nil
+}
+
+// handle calls back for the items of a successful job, adds them to the tree
+// and returns the jobs fetching their children.
+func (w *Walker) handle(r result) (children []job, err error) {
+	switch r.job.kind {
+	case spacesJob:
+		var spaces []*Space
+		for _, s := range r.spaces {
+			if !w.Filter.space(s) {
+				continue
+			}
+			space := &Space{Space: s}
+			var skip bool
+			if w.OnSpace != nil {
+				skip, err = skipped(w.OnSpace(space))
+				if err != nil {
+					return nil, err
+				}
+			}
+			spaces = append(spaces, space)
+			if !skip {
+				children = append(children, job{kind: foldersJob, space: space}, job{kind: folderlessJob, space: space})
+			}
+		}
+		w.tree.Spaces = append(w.tree.Spaces, spaces...)
+
+	case foldersJob:
+		var folders []*Folder
+		for _, f := range r.folders {
+			folder := &Folder{Folder: f, Space: r.job.space}
+			var skip bool
+			if w.OnFolder != nil {
+				skip, err = skipped(w.OnFolder(folder))
+				if err != nil {
+					return nil, err
+				}
+			}
+			folders = append(folders, folder)
+			if !skip {
+				children = append(children, job{kind: listsJob, space: r.job.space, folder: folder})
+			}
+		}
+		r.job.space.Folders = append(r.job.space.Folders, folders...)
+
+	case folderlessJob, listsJob:
+		var lists []*List
+		for _, l := range r.lists {
+			if !w.Filter.list(l) {
+				continue
+			}
+			list := &List{List: l, Space: r.job.space, Folder: r.job.folder}
+			var skip bool
+			if w.OnList != nil {
+				skip, err = skipped(w.OnList(list))
+				if err != nil {
+					return nil, err
+				}
+			}
+			lists = append(lists, list)
+			if !skip && w.Tasks {
+				children = append(children, job{kind: tasksJob, space: r.job.space, folder: r.job.folder, list: list})
+			}
+		}
+		if r.job.folder != nil {
+			r.job.folder.Lists = append(r.job.folder.Lists, lists...)
+		} else {
+			r.job.space.Lists = append(r.job.space.Lists, lists...)
+		}
+
+	case tasksJob:
+		var tasks []*Task
+		for _, t := range r.tasks {
+			task := &Task{Task: t, List: r.job.list}
+			if w.OnTask != nil {
+				if err := w.OnTask(task); err != nil && err != SkipChildren {
+					return nil, err
+				}
+			}
+			tasks = append(tasks, task)
+		}
+		r.job.list.Tasks = append(r.job.list.Tasks, tasks...)
+	}
+	return children, nil
+}
+
+// skipped reports whether the error of a callback is SkipChildren, which is
+// not an error.
+func skipped(err error) (bool, error) {
+	if err == SkipChildren {
+		return true, nil
+	}
+	return false, err
+}
+
+// fetch runs a job, fetching the archived items too if the filter asks for
+// them.
+func (w *Walker) fetch(ctx context.Context, j job) result {
+	r := result{job: j}
+	for _, archived := range w.archived() {
+		var err error
+		switch j.kind {
+		case spacesJob:
+			err = w.call(ctx, func() (*clickup.Response, error) {
+				v, resp, err := w.client.Spaces.List(ctx, w.workspaceID, archivedQuery("", archived))
+				if err == nil {
+					r.spaces = append(r.spaces, v.Spaces...)
+				}
+				return resp, err
+			})
+		case foldersJob:
+			err = w.call(ctx, func() (*clickup.Response, error) {
+				v, resp, err := w.client.Folders.List(ctx, j.space.ID, archivedQuery("", archived))
+				if err == nil {
+					r.folders = append(r.folders, v.Folders...)
+				}
+				return resp, err
+			})
+		case folderlessJob:
+			err = w.call(ctx, func() (*clickup.Response, error) {
+				v, resp, err := w.client.Lists.GetFolderlessLists(ctx, j.space.ID, archivedQuery("", archived))
+				if err == nil {
+					r.lists = append(r.lists, v.Lists...)
+				}
+				return resp, err
+			})
+		case listsJob:
+			err = w.call(ctx, func() (*clickup.Response, error) {
+				v, resp, err := w.client.Lists.GetFolderLists(ctx, j.folder.ID, archivedQuery("", archived))
+				if err == nil {
+					r.lists = append(r.lists, v.Lists...)
+				}
+				return resp, err
+			})
+		case tasksJob:
+			err = w.fetchTasks(ctx, j.list.ID, archivedQuery(w.TaskQuery, archived), &r.tasks)
+		}
+		if err != nil {
+			r.err = err
+			return r
+		}
+	}
+	return r
+}
+
+// fetchTasks appends every page of the tasks of a list to tasks.
+func (w *Walker) fetchTasks(ctx context.Context, listID, query string, tasks *[]clickup.Task) error {
+	for page := 0; ; page++ {
+		var last bool
+		err := w.call(ctx, func() (*clickup.Response, error) {
+			v, resp, err := w.client.Tasks.List(ctx, listID, addQuery(query, fmt.Sprintf("page=%d", page)))
+			if err == nil {
+				*tasks = append(*tasks, v.Tasks...)
+				last = v.LastPage || len(v.Tasks) == 0
+			}
+			return resp, err
+		})
+		if err != nil || last {
+			return err
+		}
+	}
+}
+
+// call makes a request with fn, waiting for the rate limit to reset first if
+// it is nearly used up, and again if the request is rate limited.
+func (w *Walker) call(ctx context.Context, fn func() (*clickup.Response, error)) error {
+	for {
+		rate := w.client.Rate()
+		if rate.Limit > 0 && rate.Remaining < w.minRemaining() {
+			if d := rate.Reset.Sub(w.now()); d > 0 {
+				if err := w.sleep(ctx, d); err != nil {
+					return err
+				}
+			}
+		}
+
+		_, err := fn()
+		var rle *clickup.RateLimitError
+		if !errors.As(err, &rle) {
+			return err
+		}
+		d := rle.Rate.Reset.Sub(w.now())
+		if d < time.Second {
+			d = time.Second
+		}
+		if err := w.sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *Walker) workers() int {
+	if w.Workers > 0 {
+		return w.Workers
+	}
+	return 4
+}
+
+func (w *Walker) minRemaining() int {
+	if w.MinRemaining > 0 {
+		return w.MinRemaining
+	}
+	return w.workers()
+}
+
+func (w *Walker) archived() []bool {
+	if w.Filter.Archived {
+		return []bool{false, true}
+	}
+	return []bool{false}
+}
+
+func archivedQuery(query string, archived bool) string {
+	if !archived {
+		return query
+	}
+	return addQuery(query, "archived=true")
+}
+
+// addQuery adds param to query, which is empty or starts with "?".
+func addQuery(query, param string) string {
+	if query == "" || query == "?" {
+		return "?" + param
+	}
+	if !strings.HasPrefix(query, "?") {
+		query = "?" + query
+	}
+	return query + "&" + param
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// validPattern reports whether p is well-formed in the syntax of path.Match.
+// Before Go 1.16, path.Match stops at the first mismatch and so does not
+// report every malformed pattern.
+func validPattern(p string) bool {
+	// char consumes a character of a range at p[i:], escaped or not.
+	char := func(i int) (int, bool) {
+		if i == len(p) || p[i] == '-' || p[i] == ']' {
+			return i, false
+		}
+		if p[i] == '\\' {
+			i++
+			if i == len(p) {
+				return i, false
+			}
+		}
+		return i + 1, true
+	}
+	for i := 0; i < len(p); i++ {
+		switch p[i] {
+		case '\\':
+			if i++; i == len(p) {
+				return false
+			}
+		case '[':
+			i++
+			if i < len(p) && p[i] == '^' {
+				i++
+			}
+			for n := 0; i == len(p) || p[i] != ']' || n == 0; n++ {
+				var ok bool
+				if i, ok = char(i); !ok {
+					return false
+				}
+				if i < len(p) && p[i] == '-' {
+					if i, ok = char(i + 1); !ok {
+						return false
+					}
+				}
+			}
+		}
+	}
+	return true
+}
+
+func match(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,228 @@
+package hierarchy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+// seed adds a workspace with two spaces to srv and returns its ID and the
+// ID of the second space.
+func seed(srv *clickuptest.Server) (team, other string) {
+	team = srv.AddWorkspace("Acme")
+	eng := srv.AddSpace(team, "Engineering")
+	folder := srv.AddFolder(eng, "Sprints")
+	s1 := srv.AddList(eng, folder, "Sprint 1")
+	srv.AddTask(s1, clickuptest.Task{Name: "Design"})
+	srv.AddTask(s1, clickuptest.Task{Name: "Build"})
+	s2 := srv.AddList(eng, folder, "Sprint 2")
+	srv.AddTask(s2, clickuptest.Task{Name: "Ship"})
+	backlog := srv.AddList(eng, "", "Backlog")
+	srv.AddTask(backlog, clickuptest.Task{Name: "Someday"})
+
+	other = srv.AddSpace(team, "Marketing")
+	srv.AddList(other, "", "Campaigns")
+	return team, other
+}
+
+func TestWalk(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	team, other := seed(srv)
+
+	w := NewWalker(srv.Client(), team)
+	w.Tasks = true
+	var visited []string
+	w.OnList = func(l *List) error {
+		visited = append(visited, l.Name)
+		return nil
+	}
+	tree, err := w.Walk(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tree.Spaces) != 2 || len(tree.Lists()) != 4 || len(visited) != 4 {
+		t.Fatalf("walked %d spaces, %d lists, visited %v", len(tree.Spaces), len(tree.Lists()), visited)
+	}
+	eng := tree.Spaces[0]
+	if eng.ID == other {
+		eng = tree.Spaces[1]
+	}
+	if len(eng.Folders) != 1 || len(eng.Folders[0].Lists) != 2 || len(eng.Lists) != 1 {
+		t.Fatalf("Engineering = %d folders, %d folderless lists", len(eng.Folders), len(eng.Lists))
+	}
+	sprint := eng.Folders[0].Lists[0]
+	if sprint.Folder != eng.Folders[0] || sprint.Space != eng || eng.Folders[0].Space != eng {
+		t.Errorf("parents of %s not set", sprint.Name)
+	}
+	for _, l := range tree.Lists() {
+		for _, task := range l.Tasks {
+			if task.List != l {
+				t.Errorf("parent of task %s = %v, want %s", task.Name, task.List, l.Name)
+			}
+		}
+	}
+	if n := len(tree.List(sprint.ID).Tasks); n != 2 {
+		t.Errorf("%s has %d tasks, want 2", sprint.Name, n)
+	}
+	if eng.Lists[0].Folder != nil {
+		t.Errorf("folderless list has folder %v", eng.Lists[0].Folder)
+	}
+}
+
+func TestWalkFilter(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	team, other := seed(srv)
+
+	w := NewWalker(srv.Client(), team)
+	w.Filter.ExcludeSpaceIDs = []string{other}
+	w.Filter.Lists = []string{"Sprint *"}
+	w.Filter.ExcludeLists = []string{"* 2"}
+	w.OnFolder = func(*Folder) error { return nil }
+	tree, err := w.Walk(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	lists := tree.Lists()
+	if len(tree.Spaces) != 1 || len(lists) != 1 || lists[0].Name != "Sprint 1" {
+		t.Errorf("walked %d spaces and lists %v", len(tree.Spaces), lists)
+	}
+	if len(lists[0].Tasks) != 0 {
+		t.Errorf("tasks fetched without Tasks set")
+	}
+
+	w = NewWalker(srv.Client(), team)
+	w.Filter.Lists = []string{"["}
+	if _, err := w.Walk(context.Background()); err == nil {
+		t.Error("bad pattern accepted")
+	}
+}
+
+func TestValidPattern(t *testing.T) {
+	for p, want := range map[string]bool{
+		"Sprint *":   true,
+		"[a-c]?":     true,
+		`[\]]`:       true,
+		"[^a-z0-9]*": true,
+		`\*`:         true,
+		"[^]a]":      false,
+		"Sprint [":   false,
+		"a[":         false,
+		"[]":         false,
+		"[a-]":       false,
+		"[-a]":       false,
+		"[z":         false,
+		`trailing\`:  false,
+	} {
+		if got := validPattern(p); got != want {
+			t.Errorf("validPattern(%q) = %v, want %v", p, got, want)
+		}
+	}
+}
+
+func TestWalkResume(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	team, _ := seed(srv)
+
+	srv.Fail("GET", "folder/{id}/list", 1, clickuptest.Error{Status: http.StatusInternalServerError, Message: "boom", Code: "APP_500"})
+	w := NewWalker(srv.Client(), team)
+	w.Tasks = true
+	tasks := make(map[string]int)
+	w.OnTask = func(task *Task) error {
+		tasks[task.Name]++
+		return nil
+	}
+
+	tree, err := w.Walk(context.Background())
+	var werr *Error
+	if !errors.As(err, &werr) || len(werr.Failures) != 1 {
+		t.Fatalf("Walk error = %v, want one failure", err)
+	}
+	if n := len(tree.Lists()); n != 2 {
+		t.Errorf("partial walk has %d lists, want the 2 folderless ones", n)
+	}
+
+	tree, err = w.Walk(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(tree.Lists()); n != 4 {
+		t.Errorf("resumed walk has %d lists, want 4", n)
+	}
+	for name, n := range tasks {
+		if n != 1 {
+			t.Errorf("task %s visited %d times", name, n)
+		}
+	}
+	if len(tasks) != 4 {
+		t.Errorf("visited tasks %v, want 4", tasks)
+	}
+
+	stop := errors.New("stop")
+	w = NewWalker(srv.Client(), team)
+	w.OnSpace = func(*Space) error { return stop }
+	if _, err := w.Walk(context.Background()); err != stop {
+		t.Errorf("Walk error = %v, want the callback error", err)
+	}
+	w.OnSpace = func(*Space) error { return SkipChildren }
+	tree, err = w.Walk(context.Background())
+	if err != nil || len(tree.Spaces) != 2 || len(tree.Lists()) != 0 {
+		t.Errorf("Walk skipping children = %d spaces, %d lists, %v", len(tree.Spaces), len(tree.Lists()), err)
+	}
+}
+
+// clock is a fake time advanced by the sleeps of a Walker.
+type clock struct {
+	mu     sync.Mutex
+	t      time.Time
+	slept  time.Duration
+	sleeps int
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+func (c *clock) Sleep(ctx context.Context, d time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(d)
+	c.slept += d
+	c.sleeps++
+	return nil
+}
+
+func TestWalkRateLimit(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	team, _ := seed(srv)
+	clk := &clock{t: time.Unix(1700000000, 0)}
+	srv.Now = clk.Now
+	srv.RateLimit = 3
+
+	w := NewWalker(srv.Client(), team)
+	w.Workers = 2
+	w.Tasks = true
+	w.now, w.sleep = clk.Now, clk.Sleep
+	tree, err := w.Walk(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(tree.Lists()); n != 4 {
+		t.Errorf("walked %d lists, want 4", n)
+	}
+	// 10 requests at 3 a minute.
+	if clk.sleeps == 0 || clk.slept < 3*time.Minute {
+		t.Errorf("slept %d times for %v, want to wait for the rate limit", clk.sleeps, clk.slept)
+	}
+}
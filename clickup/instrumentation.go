@@ -0,0 +1,63 @@
+package clickup
+
+import (
+	"net/http"
+	"time"
+)
+
+// Metrics receives measurements of every API call made by a Client. Endpoints
+// are path templates such as "task/{id}/comment" (see RequestInfo.Endpoint)
+// and status classes are "2xx", "3xx", "4xx", "5xx", or "error" when no
+// response was received. Implementations must be safe for concurrent use.
+type Metrics interface {
+	// IncRequest counts a finished request.
+	IncRequest(endpoint, method, statusClass string)
+	// ObserveLatency records how long a request took.
+	ObserveLatency(endpoint, method, statusClass string, d time.Duration)
+	// SetRateLimitRemaining records the number of requests left in the
+	// current rate limit window, as reported by the last response.
+	SetRateLimitRemaining(remaining int)
+}
+
+// NoopMetrics is a Metrics that discards everything.
+type NoopMetrics struct{}
+
+func (NoopMetrics) IncRequest(endpoint, method, statusClass string)                      {}
+func (NoopMetrics) ObserveLatency(endpoint, method, statusClass string, d time.Duration) {}
+func (NoopMetrics) SetRateLimitRemaining(remaining int)                                  {}
+
+func (c *Client) recordMetrics(req *http.Request, resp *Response, d time.Duration) {
+	if c.Metrics == nil {
+		return
+	}
+	if _, ok := c.Metrics.(NoopMetrics); ok {
+		return
+	}
+
+	endpoint := c.endpointTemplate(req.URL.Path)
+	class := "error"
+	if resp != nil && resp.Response != nil {
+		class = statusClass(resp.StatusCode)
+		// A cached response carries the rate limit of when it was stored.
+		if resp.Header.Get(headerRateRemaining) != "" && resp.Header.Get(headerFromCache) == "" {
+			c.Metrics.SetRateLimitRemaining(resp.Rate.Remaining)
+		}
+	}
+	c.Metrics.IncRequest(endpoint, req.Method, class)
+	c.Metrics.ObserveLatency(endpoint, req.Method, class, d)
+}
+
+func statusClass(code int) string {
+	switch {
+	case code >= 200 && code < 300:
+		return "2xx"
+	case code >= 300 && code < 400:
+		return "3xx"
+	case code >= 400 && code < 500:
+		return "4xx"
+	case code >= 500 && code < 600:
+		return "5xx"
+	default:
+		return "error"
+	}
+}
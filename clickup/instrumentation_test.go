@@ -0,0 +1,68 @@
+package clickup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+// rateServer counts the rate limit down by one on every request it serves.
+// The caller should close it.
+func rateServer() *httptest.Server {
+	remaining := 100
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remaining--
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(headerRateLimit, "100")
+		w.Header().Set(headerRateRemaining, strconv.Itoa(remaining))
+		w.Header().Set(headerRateReset, "1700000000")
+		fmt.Fprint(w, `{}`)
+	}))
+}
+
+// getThroughCache gets team, which CachingTransport caches, then user, which
+// it does not, then team again from the cache, from the server at base.
+// Only the first two responses carry a current rate limit, the cached one
+// repeating the first.
+func getThroughCache(t *testing.T, client *Client, base string) {
+	t.Helper()
+	client.BaseURL, _ = url.Parse(base + "/api/v2/")
+	for _, path := range []string{"team", "user", "team"} {
+		req, err := client.NewRequest("GET", path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Do(context.Background(), req, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+type rateMetrics struct {
+	NoopMetrics
+	remaining []int
+}
+
+func (m *rateMetrics) SetRateLimitRemaining(remaining int) {
+	m.remaining = append(m.remaining, remaining)
+}
+
+func TestMetricsSkipCachedRate(t *testing.T) {
+	srv := rateServer()
+	defer srv.Close()
+	client := NewClient((&CachingTransport{}).Client())
+	m := &rateMetrics{}
+	client.Metrics = m
+	getThroughCache(t, client, srv.URL)
+
+	if fmt.Sprint(m.remaining) != "[99 98]" {
+		t.Errorf("rate limit remaining set to %v, want [99 98]", m.remaining)
+	}
+	if client.Rate().Remaining != 98 {
+		t.Errorf("client rate = %+v, want 98 remaining", client.Rate())
+	}
+}
@@ -0,0 +1,181 @@
+package clickup
+
+import "context"
+
+// WorkspacesAPI is the API of WorkspacesService, for workspaces (teams).
+type WorkspacesAPI interface {
+	Get(ctx context.Context) (*WorkspacesWrapper, *Response, error)
+	GetSeats(ctx context.Context, workspaceId string) (*WorkspaceSeats, *Response, error)
+	CustomRoles(ctx context.Context, workspaceId string, query string) (*CustomRolesWrapper, *Response, error)
+	TaskTemplates(ctx context.Context, workspaceId string, query string) (*TaskTemplatesWrapper, *Response, error)
+	AllTaskTemplates(ctx context.Context, workspaceId string) (*TaskTemplatesWrapper, *Response, error)
+	Webhooks(ctx context.Context, workspaceId string, query string) (*WebhooksWrapper, *Response, error)
+	SharedHierarchy(ctx context.Context, workspaceId string, query string) (*SharedHierarchy, *Response, error)
+	Views(ctx context.Context, workspaceID string, query string) (*ViewsWrapper, *Response, error)
+}
+
+// SpacesAPI is the API of SpacesService, for spaces.
+type SpacesAPI interface {
+	Get(ctx context.Context, spaceID string, query string) (*Space, *Response, error)
+	List(ctx context.Context, workspaceID string, query string) (*SpacesWrapper, *Response, error)
+	Tags(ctx context.Context, spaceID string, query string) (*TagsWrapper, *Response, error)
+	Views(ctx context.Context, spaceID string, query string) (*ViewsWrapper, *Response, error)
+	CreateTag(ctx context.Context, spaceID string, tag Tag) (*Response, error)
+	EditTag(ctx context.Context, spaceID string, tagName string, tag Tag) (*Response, error)
+	DeleteTag(ctx context.Context, spaceID string, tagName string) (*Response, error)
+	SyncTags(ctx context.Context, spaceID string, desired []Tag) (*TagSyncResult, *Response, error)
+}
+
+// FoldersAPI is the API of FoldersService, for folders.
+type FoldersAPI interface {
+	Get(ctx context.Context, folderID string, query string) (*Folder, *Response, error)
+	List(ctx context.Context, spaceID string, query string) (*FoldersWrapper, *Response, error)
+	Views(ctx context.Context, folderID string, query string) (*ViewsWrapper, *Response, error)
+	CreateFromTemplate(ctx context.Context, spaceID string, templateID string, name string) (*Folder, *Response, error)
+}
+
+// ListsAPI is the API of ListsService, for lists.
+type ListsAPI interface {
+	Get(ctx context.Context, listID string, query string) (*List, *Response, error)
+	GetFolderLists(ctx context.Context, folderID string, query string) (*ListsWrapper, *Response, error)
+	GetFolderlessLists(ctx context.Context, spaceID string, query string) (*ListsWrapper, *Response, error)
+	Members(ctx context.Context, listID string, query string) (*ListMembersWrapper, *Response, error)
+	Comments(ctx context.Context, listID string, query string) (*ListCommentsWrapper, *Response, error)
+	Views(ctx context.Context, listID string, query string) (*ViewsWrapper, *Response, error)
+	CustomFields(ctx context.Context, listID string, query string) (*CustomFieldsWrapper, *Response, error)
+	CreateFromTemplate(ctx context.Context, folderID string, templateID string, name string) (*List, *Response, error)
+	CreateFolderlessFromTemplate(ctx context.Context, spaceID string, templateID string, name string) (*List, *Response, error)
+}
+
+// TasksAPI is the API of TasksService, for tasks.
+type TasksAPI interface {
+	Get(ctx context.Context, taskID string, query string) (*Task, *Response, error)
+	List(ctx context.Context, listID string, query string) (*TasksWrapper, *Response, error)
+	ForTeam(ctx context.Context, teamID string, query string) (*TasksWrapper, *Response, error)
+	Members(ctx context.Context, taskID string, query string) (*TaskMembersWrapper, *Response, error)
+	Comments(ctx context.Context, taskID string, query string) (*TaskCommentsWrapper, *Response, error)
+	AddTag(ctx context.Context, taskID string, tagName string, query string) (*Response, error)
+	RemoveTag(ctx context.Context, taskID string, tagName string, query string) (*Response, error)
+	AddDependency(ctx context.Context, taskID string, opts *TaskDependencyOptions) (*Response, error)
+	DeleteDependency(ctx context.Context, taskID string, opts *TaskDependencyOptions) (*Response, error)
+	AddTaskLink(ctx context.Context, taskID string, linksTo string) (*Task, *Response, error)
+	DeleteTaskLink(ctx context.Context, taskID string, linksTo string) (*Task, *Response, error)
+	TrackedTime(ctx context.Context, taskID string, query string) (*TrackedTimeWrapper, *Response, error)
+	TrackTime(ctx context.Context, taskID string, interval *TrackTimeRequest, query string) (*TimeInterval, *Response, error)
+	EditTrackedTime(ctx context.Context, taskID string, intervalID string, interval *TrackTimeRequest, query string) (*Response, error)
+	DeleteTrackedTime(ctx context.Context, taskID string, intervalID string, query string) (*Response, error)
+	TimeInStatus(ctx context.Context, taskID string, opts *TimeInStatusOptions) (*TaskTimeInStatus, *Response, error)
+	BulkTimeInStatus(ctx context.Context, taskIDs []string, opts *TimeInStatusOptions) (map[string]TaskTimeInStatus, *Response, error)
+	CreateFromTemplate(ctx context.Context, listID string, templateID string, name string) (*Task, *Response, error)
+}
+
+// GroupsAPI is the API of GroupsService, for user groups.
+type GroupsAPI interface {
+	Get(ctx context.Context, query string) (*GroupsWrapper, *Response, error)
+	List(ctx context.Context, teamID string, groupIDs ...string) (*GroupsWrapper, *Response, error)
+	Create(ctx context.Context, teamID string, name string, handle string, members []int64) (*Group, *Response, error)
+	Update(ctx context.Context, groupID string, update *GroupUpdate) (*Group, *Response, error)
+	Delete(ctx context.Context, groupID string) (*Response, error)
+	SyncGroups(ctx context.Context, teamID string, desired map[string][]int64, prune bool) (*GroupSyncResult, *Response, error)
+}
+
+// GoalsAPI is the API of GoalsService, for goals.
+type GoalsAPI interface {
+	List(ctx context.Context, workspaceID string, query string) (*GoalsWrapper, *Response, error)
+	Get(ctx context.Context, goalID string, query string) (*GoalWrapper, *Response, error)
+}
+
+// TimeTrackingAPI is the API of TimeTrackingService, for time entries.
+type TimeTrackingAPI interface {
+	ListEntries(ctx context.Context, teamID string, opts *TimeEntryOptions) (*TimeEntriesWrapper, *Response, error)
+	Get(ctx context.Context, teamID string, timerID string, query string) (*TimeEntry, *Response, error)
+	History(ctx context.Context, teamID string, timerID string) (*TimeEntryHistoryWrapper, *Response, error)
+	Running(ctx context.Context, teamID string, assignee int64) (*TimeEntry, *Response, error)
+	Start(ctx context.Context, teamID string, entry *TimeEntryRequest) (*TimeEntry, *Response, error)
+	Stop(ctx context.Context, teamID string) (*TimeEntry, *Response, error)
+	Create(ctx context.Context, teamID string, entry *TimeEntryRequest) (*TimeEntry, *Response, error)
+	Update(ctx context.Context, teamID string, timerID string, entry *TimeEntryRequest) (*Response, error)
+	Delete(ctx context.Context, teamID string, timerID string) (*Response, error)
+	Tags(ctx context.Context, teamID string) (*TimeEntryTagsWrapper, *Response, error)
+	AddTags(ctx context.Context, teamID string, timerIDs []string, tags []Tag) (*Response, error)
+	RemoveTags(ctx context.Context, teamID string, timerIDs []string, tags []Tag) (*Response, error)
+	EditTag(ctx context.Context, teamID string, tagName string, tag Tag) (*Response, error)
+}
+
+// UsersAPI is the API of UsersService, for workspace users.
+type UsersAPI interface {
+	Invite(ctx context.Context, teamID string, email string, admin bool, customRoleID int64) (*Workspace, *Response, error)
+	Get(ctx context.Context, teamID string, userID int64) (*Member, *Response, error)
+	Edit(ctx context.Context, teamID string, userID int64, user *EditUserRequest) (*Member, *Response, error)
+	Remove(ctx context.Context, teamID string, userID int64) (*Workspace, *Response, error)
+}
+
+// GuestsAPI is the API of GuestsService, for workspace guests.
+type GuestsAPI interface {
+	Invite(ctx context.Context, teamID string, guest *GuestRequest) (*Workspace, *Response, error)
+	Get(ctx context.Context, teamID string, guestID int64) (*Guest, *Response, error)
+	Edit(ctx context.Context, teamID string, guestID int64, guest *GuestRequest) (*Guest, *Response, error)
+	Remove(ctx context.Context, teamID string, guestID int64) (*Workspace, *Response, error)
+	AddToTask(ctx context.Context, taskID string, guestID int64, level Permission, query string) (*Guest, *Response, error)
+	RemoveFromTask(ctx context.Context, taskID string, guestID int64, query string) (*Guest, *Response, error)
+	AddToList(ctx context.Context, listID string, guestID int64, level Permission, query string) (*Guest, *Response, error)
+	RemoveFromList(ctx context.Context, listID string, guestID int64, query string) (*Guest, *Response, error)
+	AddToFolder(ctx context.Context, folderID string, guestID int64, level Permission, query string) (*Guest, *Response, error)
+	RemoveFromFolder(ctx context.Context, folderID string, guestID int64, query string) (*Guest, *Response, error)
+}
+
+// ViewsAPI is the API of ViewsService, for views.
+type ViewsAPI interface {
+	Get(ctx context.Context, viewID string, query string) (*ViewWrapper, *Response, error)
+	Tasks(ctx context.Context, viewID string, query string) (*TasksWrapper, *Response, error)
+	Comments(ctx context.Context, viewID string, query string) (*ChatViewCommentsWrapper, *Response, error)
+}
+
+var (
+	_ WorkspacesAPI   = (*WorkspacesService)(nil)
+	_ SpacesAPI       = (*SpacesService)(nil)
+	_ FoldersAPI      = (*FoldersService)(nil)
+	_ ListsAPI        = (*ListsService)(nil)
+	_ TasksAPI        = (*TasksService)(nil)
+	_ GroupsAPI       = (*GroupsService)(nil)
+	_ GoalsAPI        = (*GoalsService)(nil)
+	_ TimeTrackingAPI = (*TimeTrackingService)(nil)
+	_ UsersAPI        = (*UsersService)(nil)
+	_ GuestsAPI       = (*GuestsService)(nil)
+	_ ViewsAPI        = (*ViewsService)(nil)
+)
+
+// API gives access to the services of a Client through interfaces, so that
+// code depending on it can be tested with the mocks of package clickupmock
+// instead of an HTTP server. Methods returning a *TaskIterator are left out
+// of TasksAPI; they are built on List and ForTeam.
+type API struct {
+	Workspaces   WorkspacesAPI
+	Spaces       SpacesAPI
+	Folders      FoldersAPI
+	Lists        ListsAPI
+	Tasks        TasksAPI
+	Groups       GroupsAPI
+	Goals        GoalsAPI
+	TimeTracking TimeTrackingAPI
+	Users        UsersAPI
+	Guests       GuestsAPI
+	Views        ViewsAPI
+}
+
+// API returns the services of c as an API.
+func (c *Client) API() *API {
+	return &API{
+		Workspaces:   c.Workspaces,
+		Spaces:       c.Spaces,
+		Folders:      c.Folders,
+		Lists:        c.Lists,
+		Tasks:        c.Tasks,
+		Groups:       c.Groups,
+		Goals:        c.Goals,
+		TimeTracking: c.TimeTracking,
+		Users:        c.Users,
+		Guests:       c.Guests,
+		Views:        c.Views,
+	}
+}
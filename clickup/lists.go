@@ -45,13 +45,28 @@ type List struct {
 	Statuses         []struct {
 		ID         string `json:"id"`
 		Status     string `json:"status"`
-		OrderIndex int64  `json:"order_index"`
+		OrderIndex int64  `json:"orderindex"`
 		Color      string `json:"color"`
 		Type       string `json:"type"`
 	} `json:"statuses"`
 	PermissionLevel string `json:"permission_level"`
 }
 
+type CustomFieldsWrapper struct {
+	Fields []CustomField `json:"fields"`
+}
+
+// CustomField is a custom field available to the tasks of a list.
+type CustomField struct {
+	ID             string      `json:"id"`
+	Name           string      `json:"name"`
+	Type           string      `json:"type"`
+	TypeConfig     interface{} `json:"type_config"`
+	DateCreated    string      `json:"date_created"`
+	HideFromGuests bool        `json:"hide_from_guests"`
+	Required       bool        `json:"required"`
+}
+
 type ListMembersWrapper struct {
 	Members []ListMember `json:"members"`
 }
@@ -117,8 +132,6 @@ func (s *ListsService) Get(ctx context.Context, listID string, query string) (*L
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(List)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -134,8 +147,6 @@ func (s *ListsService) GetFolderLists(ctx context.Context, folderID string, quer
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(ListsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -152,8 +163,6 @@ func (s *ListsService) GetFolderlessLists(ctx context.Context, spaceID string, q
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(ListsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -170,8 +179,6 @@ func (s *ListsService) Members(ctx context.Context, listID string, query string)
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(ListMembersWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -188,8 +195,6 @@ func (s *ListsService) Comments(ctx context.Context, listID string, query string
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(ListCommentsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -205,8 +210,6 @@ func (s *ListsService) Views(ctx context.Context, listID string, query string) (
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(ViewsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -215,3 +218,52 @@ func (s *ListsService) Views(ctx context.Context, listID string, query string) (
 
 	return wResp, resp, nil
 }
+
+// CustomFields returns the custom fields accessible from a list.
+func (s *ListsService) CustomFields(ctx context.Context, listID string, query string) (*CustomFieldsWrapper, *Response, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("list/%s/field%s", listID, query), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(CustomFieldsWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wResp, resp, nil
+}
+
+// CreateFromTemplate creates a list called name in a folder from a list
+// template.
+func (s *ListsService) CreateFromTemplate(ctx context.Context, folderID string, templateID string, name string) (*List, *Response, error) {
+	return s.createFromTemplate(ctx, fmt.Sprintf("folder/%s/list_template/%s", folderID, templateID), name)
+}
+
+// CreateFolderlessFromTemplate creates a list called name directly in a space
+// from a list template.
+func (s *ListsService) CreateFolderlessFromTemplate(ctx context.Context, spaceID string, templateID string, name string) (*List, *Response, error) {
+	return s.createFromTemplate(ctx, fmt.Sprintf("space/%s/list_template/%s", spaceID, templateID), name)
+}
+
+func (s *ListsService) createFromTemplate(ctx context.Context, urlStr string, name string) (*List, *Response, error) {
+	req, err := s.client.NewRequest("POST", urlStr, &templateRequest{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(struct {
+		ID   string `json:"id"`
+		List List   `json:"list"`
+	})
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if wResp.List.ID == "" {
+		wResp.List.ID = wResp.ID
+	}
+	return &wResp.List, resp, nil
+}
@@ -0,0 +1,163 @@
+package clickup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Logger is the logging interface used by Client. It is satisfied by
+// *slog.Logger from the standard library.
+type Logger interface {
+	DebugContext(ctx context.Context, msg string, args ...interface{})
+	ErrorContext(ctx context.Context, msg string, args ...interface{})
+}
+
+// RequestInfo describes a request about to be sent.
+type RequestInfo struct {
+	Method string
+	// Endpoint is the path template of the request relative to the base URL,
+	// with IDs and names replaced by {id}, e.g. "task/{id}/comment".
+	Endpoint string
+	// URL is the full URL with credentials redacted.
+	URL string
+	// Body is the request body with credentials redacted, truncated to
+	// maxHookBody bytes.
+	Body string
+}
+
+// ResponseInfo describes the outcome of a request.
+type ResponseInfo struct {
+	Request *RequestInfo
+
+	// StatusCode is 0 when no response was received.
+	StatusCode int
+	Duration   time.Duration
+	// Cached is set when CachingTransport served the response. Rate is then
+	// left zero, the cached headers being those of an earlier request.
+	Cached bool
+	Rate   Rate
+	// Body is the response body with credentials redacted, truncated to
+	// maxHookBody bytes.
+	Body string
+	// Err is the error returned to the caller, if any.
+	Err error
+}
+
+// maxHookBody is the number of body bytes passed to hooks.
+const maxHookBody = 4096
+
+// staticSegments are the path segments of ClickUp endpoints that are not IDs
+// or names.
+var staticSegments = map[string]bool{
+	"attachment": true, "bulk_time_in_status": true, "checklist": true,
+	"checklist_item": true, "comment": true, "current": true,
+	"customroles": true, "dependency": true, "field": true, "folder": true,
+	"folder_template": true, "goal": true, "group": true, "guest": true,
+	"history": true, "key_result": true, "link": true, "list": true,
+	"list_template": true, "member": true, "oauth": true, "rate_limit": true,
+	"shared": true, "space": true, "start": true, "stop": true, "tag": true,
+	"tags": true, "task": true, "task_ids": true, "taskTemplate": true,
+	"team": true, "time": true, "time_entries": true, "time_in_status": true,
+	"token": true, "user": true, "view": true, "webhook": true,
+}
+
+// endpointTemplate returns path relative to the base URL with every
+// segment that is not a known part of an endpoint replaced by {id}.
+func (c *Client) endpointTemplate(path string) string {
+	return templatePath(path, c.BaseURL.Path)
+}
+
+func templatePath(path, basePath string) string {
+	path = strings.TrimPrefix(path, basePath)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, seg := range segments {
+		if !staticSegments[seg] {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func (c *Client) observed() bool {
+	return c.Logger != nil || c.OnRequest != nil || c.OnResponse != nil
+}
+
+func (c *Client) requestInfo(req *http.Request) *RequestInfo {
+	info := &RequestInfo{
+		Method:   req.Method,
+		Endpoint: c.endpointTemplate(req.URL.Path),
+		URL:      sanitizeURL(req.URL).String(),
+	}
+	if c.OnRequest != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			info.Body = readHookBody(body)
+			body.Close()
+		}
+	}
+	return info
+}
+
+func (c *Client) beforeRequest(ctx context.Context, info *RequestInfo) {
+	if c.Logger != nil {
+		c.Logger.DebugContext(ctx, "clickup request",
+			"method", info.Method, "endpoint", info.Endpoint, "url", info.URL)
+	}
+	if c.OnRequest != nil {
+		c.OnRequest(ctx, info)
+	}
+}
+
+func (c *Client) afterResponse(ctx context.Context, req *RequestInfo, resp *Response, err error, d time.Duration) {
+	info := &ResponseInfo{Request: req, Duration: d, Err: err}
+	if resp != nil && resp.Response != nil {
+		info.StatusCode = resp.StatusCode
+		info.Cached = resp.Header.Get(headerFromCache) != ""
+		if !info.Cached {
+			info.Rate = resp.Rate
+		}
+		if c.OnResponse != nil && resp.Body != nil {
+			// Read the start of the body and put it back in front of the
+			// rest for the caller, so at most maxHookBody bytes are
+			// buffered however large the response.
+			head, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxHookBody))
+			resp.Body = peekedBody{io.MultiReader(bytes.NewReader(head), resp.Body), resp.Body}
+			info.Body = Redact(string(head))
+		}
+	}
+
+	if c.Logger != nil {
+		args := []interface{}{
+			"method", req.Method, "endpoint", req.Endpoint, "url", req.URL,
+			"status", info.StatusCode, "duration", d,
+			"rate_limit", info.Rate.Limit, "rate_remaining", info.Rate.Remaining,
+		}
+		if info.Cached {
+			args = append(args, "cached", true)
+		}
+		if err != nil {
+			c.Logger.ErrorContext(ctx, "clickup request failed", append(args, "error", err)...)
+		} else {
+			c.Logger.DebugContext(ctx, "clickup response", args...)
+		}
+	}
+	if c.OnResponse != nil {
+		c.OnResponse(ctx, info)
+	}
+}
+
+// peekedBody is a response body whose start has been read and is replayed
+// before the rest of it.
+type peekedBody struct {
+	io.Reader
+	io.Closer
+}
+
+func readHookBody(r io.Reader) string {
+	data, _ := ioutil.ReadAll(io.LimitReader(r, maxHookBody))
+	return Redact(string(data))
+}
@@ -0,0 +1,184 @@
+package clickup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTemplatePath(t *testing.T) {
+	tests := []struct{ path, base, want string }{
+		{"/api/v2/task/abc123/comment", "/api/v2/", "task/{id}/comment"},
+		{"/api/v2/team/1/time_entries/current", "/api/v2/", "team/{id}/time_entries/current"},
+		{"/space/9/tag/urgent", "/", "space/{id}/tag/{id}"},
+		{"/v2/list/5/field/abc-def", "/v2/", "list/{id}/field/{id}"},
+		{"/user", "/", "user"},
+	}
+	for _, tt := range tests {
+		if got := templatePath(tt.path, tt.base); got != tt.want {
+			t.Errorf("templatePath(%q, %q) = %q, want %q", tt.path, tt.base, got, tt.want)
+		}
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		header []string
+		want   Rate
+	}{
+		{
+			[]string{headerRateLimit, "100", headerRateRemaining, "42", headerRateReset, "1700000000"},
+			Rate{Limit: 100, Remaining: 42, Reset: Timestamp{time.Unix(1700000000, 0)}},
+		},
+		{[]string{headerRateLimit, "100", headerRateRemaining, "0"}, Rate{Limit: 100}},
+		{[]string{headerRateLimit, "lots", headerRateReset, "0"}, Rate{}},
+		{nil, Rate{}},
+	}
+	for _, tt := range tests {
+		header := http.Header{}
+		for i := 0; i < len(tt.header); i += 2 {
+			header.Set(tt.header[i], tt.header[i+1])
+		}
+		got := parseRate(&http.Response{Header: header})
+		if got.Limit != tt.want.Limit || got.Remaining != tt.want.Remaining || !got.Reset.Time.Equal(tt.want.Reset.Time) {
+			t.Errorf("parseRate(%v) = %+v, want %+v", header, got, tt.want)
+		}
+	}
+}
+
+type logRecord struct {
+	level string
+	msg   string
+	args  []interface{}
+}
+
+type testLogger struct {
+	records []logRecord
+}
+
+func (l *testLogger) DebugContext(ctx context.Context, msg string, args ...interface{}) {
+	l.records = append(l.records, logRecord{"debug", msg, args})
+}
+
+func (l *testLogger) ErrorContext(ctx context.Context, msg string, args ...interface{}) {
+	l.records = append(l.records, logRecord{"error", msg, args})
+}
+
+func (r logRecord) arg(key string) interface{} {
+	for i := 0; i+1 < len(r.args); i += 2 {
+		if r.args[i] == key {
+			return r.args[i+1]
+		}
+	}
+	return nil
+}
+
+func TestHooks(t *testing.T) {
+	large := `{"access_token":"` + testAccessToken + `","pad":"` + strings.Repeat("x", 2*maxHookBody) + `"}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "100")
+		w.Header().Set(headerRateRemaining, "99")
+		if strings.HasPrefix(r.URL.Path, "/task/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"err":"Task not found","ECODE":"ITEM_013"}`)
+			return
+		}
+		fmt.Fprint(w, large)
+	}))
+	defer srv.Close()
+
+	client := NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+	logger := &testLogger{}
+	client.Logger = logger
+	var requests []*RequestInfo
+	var responses []*ResponseInfo
+	client.OnRequest = func(ctx context.Context, info *RequestInfo) { requests = append(requests, info) }
+	client.OnResponse = func(ctx context.Context, info *ResponseInfo) { responses = append(responses, info) }
+	ctx := context.Background()
+
+	req, err := client.NewRequest("PUT", "task/abc123?client_secret="+testClientSecret, map[string]string{"client_secret": testClientSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body bytes.Buffer
+	if _, err := client.Do(ctx, req, &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.String() != large {
+		t.Errorf("caller read %d bytes of the %d byte body", body.Len(), len(large))
+	}
+
+	req, _ = client.NewRequest("GET", "task/missing", nil)
+	if _, err := client.Do(ctx, req, nil); err == nil {
+		t.Fatal("GET task/missing succeeded")
+	}
+
+	if len(requests) != 2 || len(responses) != 2 {
+		t.Fatalf("hooks saw %d requests and %d responses, want 2 each", len(requests), len(responses))
+	}
+	if r := requests[0]; r.Method != "PUT" || r.Endpoint != "task/{id}" || !strings.Contains(r.Body, "client_secret") {
+		t.Errorf("request info = %+v", r)
+	}
+	assertNoSecrets(t, requests[0].URL)
+	assertNoSecrets(t, requests[0].Body)
+
+	if r := responses[0]; r.Request != requests[0] || r.StatusCode != 200 || r.Rate.Remaining != 99 || r.Err != nil {
+		t.Errorf("response info = %+v", r)
+	}
+	if n := len(responses[0].Body); n > maxHookBody+len(redacted) {
+		t.Errorf("response hook got %d body bytes, want at most %d", n, maxHookBody)
+	}
+	assertNoSecrets(t, responses[0].Body)
+	if r := responses[1]; r.StatusCode != 404 || r.Err == nil {
+		t.Errorf("response info of a failed request = %+v", r)
+	}
+
+	var levels []string
+	for _, r := range logger.records {
+		levels = append(levels, r.level+" "+r.msg)
+	}
+	want := []string{"debug clickup request", "debug clickup response", "debug clickup request", "error clickup request failed"}
+	if strings.Join(levels, ", ") != strings.Join(want, ", ") {
+		t.Errorf("log records = %q, want %q", levels, want)
+	}
+	if got := logger.records[3].arg("endpoint"); got != "task/{id}" {
+		t.Errorf("endpoint logged = %v, want task/{id}", got)
+	}
+	if got := logger.records[3].arg("status"); got != 404 {
+		t.Errorf("status logged = %v, want 404", got)
+	}
+	for _, r := range logger.records {
+		assertNoSecrets(t, fmt.Sprint(r.args...))
+	}
+}
+
+func TestHooksCachedRate(t *testing.T) {
+	srv := rateServer()
+	defer srv.Close()
+	client := NewClient((&CachingTransport{}).Client())
+	logger := &testLogger{}
+	client.Logger = logger
+	var responses []*ResponseInfo
+	client.OnResponse = func(ctx context.Context, info *ResponseInfo) { responses = append(responses, info) }
+	getThroughCache(t, client, srv.URL)
+
+	var got []string
+	for _, r := range responses {
+		got = append(got, fmt.Sprintf("%s %v %d", r.Request.Endpoint, r.Cached, r.Rate.Remaining))
+	}
+	want := "[team false 99 user false 98 team true 0]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("responses = %v, want %s", got, want)
+	}
+	last := logger.records[len(logger.records)-1]
+	if last.arg("cached") != true || last.arg("rate_remaining") != 0 {
+		t.Errorf("cached response logged with %v", last.args)
+	}
+}
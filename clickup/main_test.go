@@ -1 +1,18 @@
-package clickup
+package clickup_test
+
+import (
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+// setup starts a fake ClickUp server with a workspace and returns it, a
+// client of it and the ID of the workspace. The caller should close the
+// server.
+func setup(t *testing.T) (*clickuptest.Server, *clickup.Client, string) {
+	t.Helper()
+	srv := clickuptest.NewServer()
+	team := srv.AddWorkspace("Acme")
+	return srv, srv.Client(), team
+}
@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CFD is the data behind a cumulative flow diagram: for every sample time the
+// number of items in each status.
+type CFD struct {
+	// Statuses are the column names, ordered by the status order index.
+	Statuses []string
+	Samples  []CFDSample
+}
+
+type CFDSample struct {
+	At     time.Time
+	Counts map[string]int
+}
+
+// CumulativeFlow samples the status of every item at every step from from to
+// to, both included. Items that do not exist yet at a sample are not counted.
+// step must be positive.
+func CumulativeFlow(items []Item, from, to time.Time, step time.Duration) (*CFD, error) {
+	if err := checkStep(step); err != nil {
+		return nil, err
+	}
+	cfd := &CFD{}
+	order := make(map[string]int64)
+	names := make(map[string]string)
+	for _, it := range items {
+		for _, tr := range it.Transitions {
+			key := strings.ToLower(tr.Status)
+			if _, ok := names[key]; !ok {
+				names[key] = tr.Status
+				order[key] = tr.OrderIndex
+			}
+		}
+	}
+	keys := make([]string, 0, len(names))
+	for k := range names {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if order[keys[i]] != order[keys[j]] {
+			return order[keys[i]] < order[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	for _, k := range keys {
+		cfd.Statuses = append(cfd.Statuses, names[k])
+	}
+
+	for t := from; !t.After(to); t = t.Add(step) {
+		s := CFDSample{At: t, Counts: make(map[string]int, len(keys))}
+		for _, it := range items {
+			if tr, ok := it.StatusAt(t); ok {
+				s.Counts[names[strings.ToLower(tr.Status)]]++
+			}
+		}
+		cfd.Samples = append(cfd.Samples, s)
+	}
+	return cfd, nil
+}
+
+// WriteCSV writes one row per sample with a date column followed by a count
+// column per status.
+func (c *CFD) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append([]string{"date"}, c.Statuses...)); err != nil {
+		return err
+	}
+	for _, s := range c.Samples {
+		record := []string{s.At.Format(time.RFC3339)}
+		for _, status := range c.Statuses {
+			record = append(record, strconv.Itoa(s.Counts[status]))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteSamplesCSV writes WIP or throughput samples as date,count rows.
+func WriteSamplesCSV(w io.Writer, samples []Sample) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"date", "count"}); err != nil {
+		return err
+	}
+	for _, s := range samples {
+		if err := cw.Write([]string{s.At.Format(time.RFC3339), strconv.Itoa(s.Count)}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
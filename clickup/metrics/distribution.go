@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Distribution summarises a set of durations.
+type Distribution struct {
+	Count int
+	Min   time.Duration
+	Max   time.Duration
+	Mean  time.Duration
+	P50   time.Duration
+	P85   time.Duration
+	P95   time.Duration
+}
+
+// Distribute summarises ds.
+func Distribute(ds []time.Duration) Distribution {
+	if len(ds) == 0 {
+		return Distribution{}
+	}
+	sorted := sortDurations(ds)
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	return Distribution{
+		Count: len(sorted),
+		Min:   sorted[0],
+		Max:   sorted[len(sorted)-1],
+		Mean:  sum / time.Duration(len(sorted)),
+		P50:   percentile(sorted, 50),
+		P85:   percentile(sorted, 85),
+		P95:   percentile(sorted, 95),
+	}
+}
+
+// Percentile returns the p-th percentile (0 < p <= 100) of ds using the
+// nearest-rank method.
+func Percentile(ds []time.Duration, p float64) time.Duration {
+	if len(ds) == 0 {
+		return 0
+	}
+	return percentile(sortDurations(ds), p)
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+func sortDurations(ds []time.Duration) []time.Duration {
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Load fetches the time in status of tasks and returns them as items.
+func Load(ctx context.Context, c *clickup.Client, tasks []clickup.Task) ([]Item, error) {
+	ids := make([]string, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.ID
+	}
+	tis, _, err := c.Tasks.BulkTimeInStatus(ctx, ids, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, len(tasks))
+	for i, t := range tasks {
+		var h *clickup.TaskTimeInStatus
+		if v, ok := tis[t.ID]; ok {
+			h = &v
+		}
+		items[i] = NewItem(t, h)
+	}
+	return items, nil
+}
+
+// ListItems loads every task of a list, including closed ones, as items.
+// query holds extra filters in the form accepted by Tasks.List.
+func ListItems(ctx context.Context, c *clickup.Client, listID string, query string) ([]Item, error) {
+	return loadAll(ctx, c, c.Tasks.ListIter(ctx, listID, withClosed(query)))
+}
+
+// TeamItems loads every task matching a filtered team query, including closed
+// ones, as items. query holds filters in the form accepted by Tasks.ForTeam.
+func TeamItems(ctx context.Context, c *clickup.Client, teamID string, query string) ([]Item, error) {
+	return loadAll(ctx, c, c.Tasks.ForTeamIter(ctx, teamID, withClosed(query)))
+}
+
+func loadAll(ctx context.Context, c *clickup.Client, it *clickup.TaskIterator) ([]Item, error) {
+	defer it.Close()
+	var tasks []clickup.Task
+	for it.Next() {
+		tasks = append(tasks, it.Task())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return Load(ctx, c, tasks)
+}
+
+// withClosed adds include_closed to query, which may or may not start with
+// "?".
+func withClosed(query string) string {
+	if query = strings.TrimPrefix(query, "?"); query != "" {
+		return "?" + query + "&include_closed=true"
+	}
+	return "?include_closed=true"
+}
@@ -0,0 +1,293 @@
+// Package metrics computes flow metrics (lead time, cycle time, work in
+// progress, throughput and cumulative flow) from ClickUp tasks and their
+// status history.
+//
+// ClickUp reports, for every status a task has been in, when it first entered
+// it and how long it spent there in total. The status changes of a task are
+// reconstructed from those entry times, so a task that moves back to a status
+// it already visited is seen as having entered it only once.
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Transition is a task entering a status.
+type Transition struct {
+	Status     string
+	Type       string
+	OrderIndex int64
+	At         time.Time
+}
+
+// Item is a task along with its status changes in chronological order.
+type Item struct {
+	TaskID      string
+	Name        string
+	Created     time.Time
+	Closed      time.Time
+	Transitions []Transition
+}
+
+// NewItem builds an Item from a task and its time in status, which may be nil
+// when unknown.
+func NewItem(task clickup.Task, tis *clickup.TaskTimeInStatus) Item {
+	item := Item{
+		TaskID:  task.ID,
+		Name:    task.Name,
+		Created: parseMillis(task.DateCreated),
+		Closed:  parseMillis(task.DateClosed),
+	}
+	if tis == nil {
+		return item
+	}
+
+	// Copy the history so appending the current status cannot write into
+	// the caller's array.
+	history := make([]clickup.StatusHistory, 0, len(tis.StatusHistory)+1)
+	history = append(append(history, tis.StatusHistory...), tis.CurrentStatus)
+
+	seen := make(map[string]bool, len(history))
+	for _, h := range history {
+		key := strings.ToLower(h.Status)
+		if h.Status == "" || seen[key] || h.Since.IsZero() {
+			continue
+		}
+		seen[key] = true
+		typ := h.Type
+		if typ == "" && strings.EqualFold(h.Status, task.Status.Status) {
+			typ = task.Status.Type
+		}
+		item.Transitions = append(item.Transitions, Transition{
+			Status:     h.Status,
+			Type:       typ,
+			OrderIndex: h.OrderIndex,
+			At:         h.Since.Time,
+		})
+	}
+	sort.SliceStable(item.Transitions, func(i, j int) bool {
+		return item.Transitions[i].At.Before(item.Transitions[j].At)
+	})
+	return item
+}
+
+// StatusAt returns the status the item was in at t, and false if it did not
+// exist yet or has no known status then.
+func (it Item) StatusAt(t time.Time) (Transition, bool) {
+	var current Transition
+	found := false
+	for _, tr := range it.Transitions {
+		if tr.At.After(t) {
+			break
+		}
+		current, found = tr, true
+	}
+	return current, found
+}
+
+// Boundary selects statuses by name (compared case-insensitively) or by
+// ClickUp status type ("open", "custom", "closed" or "done").
+type Boundary struct {
+	Statuses []string
+	Types    []string
+}
+
+func (b Boundary) matches(tr Transition) bool {
+	for _, s := range b.Statuses {
+		if strings.EqualFold(s, tr.Status) {
+			return true
+		}
+	}
+	for _, t := range b.Types {
+		if strings.EqualFold(t, tr.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// Config defines when work starts and ends. The zero value starts the cycle
+// at the first status that is not of type "open" and ends it at the first
+// status of type "closed" or "done".
+type Config struct {
+	CycleStart Boundary
+	CycleEnd   Boundary
+}
+
+func (c Config) start() Boundary {
+	if len(c.CycleStart.Statuses) == 0 && len(c.CycleStart.Types) == 0 {
+		return Boundary{Types: []string{"custom", "closed", "done"}}
+	}
+	return c.CycleStart
+}
+
+func (c Config) end() Boundary {
+	if len(c.CycleEnd.Statuses) == 0 && len(c.CycleEnd.Types) == 0 {
+		return Boundary{Types: []string{"closed", "done"}}
+	}
+	return c.CycleEnd
+}
+
+// Started returns when work on the item started.
+func (c Config) Started(it Item) (time.Time, bool) {
+	b := c.start()
+	for _, tr := range it.Transitions {
+		if b.matches(tr) {
+			return tr.At, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Finished returns when work on the item finished, falling back to the date
+// the task was closed when its status history does not tell.
+func (c Config) Finished(it Item) (time.Time, bool) {
+	b := c.end()
+	for _, tr := range it.Transitions {
+		if b.matches(tr) {
+			return tr.At, true
+		}
+	}
+	if !it.Closed.IsZero() {
+		return it.Closed, true
+	}
+	return time.Time{}, false
+}
+
+// LeadTime returns the time from creation to finish of an item.
+func (c Config) LeadTime(it Item) (time.Duration, bool) {
+	end, ok := c.Finished(it)
+	if !ok || it.Created.IsZero() {
+		return 0, false
+	}
+	return end.Sub(it.Created), true
+}
+
+// CycleTime returns the time from start to finish of an item.
+func (c Config) CycleTime(it Item) (time.Duration, bool) {
+	start, ok := c.Started(it)
+	if !ok {
+		return 0, false
+	}
+	end, ok := c.Finished(it)
+	if !ok || end.Before(start) {
+		return 0, false
+	}
+	return end.Sub(start), true
+}
+
+// LeadTimes returns the lead time of every finished item.
+func (c Config) LeadTimes(items []Item) []time.Duration {
+	var ds []time.Duration
+	for _, it := range items {
+		if d, ok := c.LeadTime(it); ok {
+			ds = append(ds, d)
+		}
+	}
+	return ds
+}
+
+// CycleTimes returns the cycle time of every finished item.
+func (c Config) CycleTimes(items []Item) []time.Duration {
+	var ds []time.Duration
+	for _, it := range items {
+		if d, ok := c.CycleTime(it); ok {
+			ds = append(ds, d)
+		}
+	}
+	return ds
+}
+
+// InProgress reports whether work on the item had started but not finished
+// at t.
+func (c Config) InProgress(it Item, t time.Time) bool {
+	start, ok := c.Started(it)
+	if !ok || start.After(t) {
+		return false
+	}
+	end, ok := c.Finished(it)
+	return !ok || end.After(t)
+}
+
+// Sample is a value measured at a point in time.
+type Sample struct {
+	At    time.Time
+	Count int
+}
+
+// WIP returns the number of items in progress at every step from from to to,
+// both included. step must be positive.
+func (c Config) WIP(items []Item, from, to time.Time, step time.Duration) ([]Sample, error) {
+	if err := checkStep(step); err != nil {
+		return nil, err
+	}
+	var samples []Sample
+	for t := from; !t.After(to); t = t.Add(step) {
+		n := 0
+		for _, it := range items {
+			if c.InProgress(it, t) {
+				n++
+			}
+		}
+		samples = append(samples, Sample{At: t, Count: n})
+	}
+	return samples, nil
+}
+
+func checkStep(step time.Duration) error {
+	if step <= 0 {
+		return fmt.Errorf("metrics: step must be positive, got %v", step)
+	}
+	return nil
+}
+
+// Throughput returns the number of items finished in each week from the week
+// containing from to the week containing to. Weeks start on Monday in the
+// location of from, and items are counted in the week of the calendar date
+// they finished on there, so weeks spanning a DST change are not skewed.
+func (c Config) Throughput(items []Item, from, to time.Time) []Sample {
+	first := weekStart(from)
+	var weeks []Sample
+	for w := first; !w.After(to); w = w.AddDate(0, 0, 7) {
+		weeks = append(weeks, Sample{At: w})
+	}
+	for _, it := range items {
+		end, ok := c.Finished(it)
+		if !ok || end.Before(first) || end.After(to) {
+			continue
+		}
+		i := days(first, weekStart(end.In(first.Location()))) / 7
+		if i >= 0 && i < len(weeks) {
+			weeks[i].Count++
+		}
+	}
+	return weeks
+}
+
+func weekStart(t time.Time) time.Time {
+	y, m, d := t.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) + 6) % 7 // days since Monday
+	return day.AddDate(0, 0, -offset)
+}
+
+// days returns the number of calendar days from the date of a to that of b.
+func days(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return int(time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+}
+
+func parseMillis(s string) time.Time {
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
@@ -0,0 +1,210 @@
+package metrics
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+var day0 = time.Date(2023, 11, 1, 9, 0, 0, 0, time.UTC)
+
+func at(days int) time.Time {
+	return day0.AddDate(0, 0, days)
+}
+
+func millis(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+func status(name, typ string, order int64, since time.Time) clickup.StatusHistory {
+	return clickup.StatusHistory{Status: name, Type: typ, OrderIndex: order, Since: clickup.Timestamp{Time: since}}
+}
+
+// item returns a task created on day 0 that entered each status of history
+// and is now in current.
+func item(id string, current clickup.StatusHistory, history ...clickup.StatusHistory) Item {
+	task := clickup.Task{ID: id, DateCreated: millis(day0)}
+	return NewItem(task, &clickup.TaskTimeInStatus{CurrentStatus: current, StatusHistory: history})
+}
+
+// items are a task done on day 5 after starting on day 1, one in progress
+// since day 2 and one never started.
+func items() []Item {
+	open := status("to do", "open", 0, day0)
+	return []Item{
+		item("a", status("done", "closed", 2, at(5)), open, status("doing", "custom", 1, at(1))),
+		item("b", status("doing", "custom", 1, at(2)), open),
+		item("c", open),
+	}
+}
+
+func TestNewItem(t *testing.T) {
+	task := clickup.Task{ID: "a", DateCreated: millis(day0), DateClosed: millis(at(3))}
+	task.Status.Status, task.Status.Type = "done", "closed"
+	it := NewItem(task, &clickup.TaskTimeInStatus{
+		CurrentStatus: status("done", "", 2, at(3)),
+		StatusHistory: []clickup.StatusHistory{
+			status("to do", "open", 0, day0),
+			status("Doing", "custom", 1, at(1)),
+			// Revisited statuses count from their first entry.
+			status("doing", "custom", 1, at(2)),
+		},
+	})
+
+	want := []Transition{
+		{"to do", "open", 0, day0},
+		{"Doing", "custom", 1, at(1)},
+		{"done", "closed", 2, at(3)},
+	}
+	if !reflect.DeepEqual(it.Transitions, want) {
+		t.Errorf("Transitions = %+v, want %+v", it.Transitions, want)
+	}
+	if !it.Created.Equal(day0) || !it.Closed.Equal(at(3)) {
+		t.Errorf("Created, Closed = %v, %v", it.Created, it.Closed)
+	}
+
+	// The history passed in has spare capacity; NewItem must not use it.
+	history := make([]clickup.StatusHistory, 1, 2)
+	history[0] = status("to do", "open", 0, day0)
+	spare := history[:2]
+	spare[1] = status("untouched", "", 0, day0)
+	NewItem(task, &clickup.TaskTimeInStatus{CurrentStatus: status("done", "closed", 2, at(3)), StatusHistory: history})
+	if spare[1].Status != "untouched" {
+		t.Errorf("NewItem wrote %q into the spare capacity of the history", spare[1].Status)
+	}
+}
+
+func TestTimes(t *testing.T) {
+	var c Config
+	its := items()
+	if got, want := c.LeadTimes(its), []time.Duration{5 * 24 * time.Hour}; !reflect.DeepEqual(got, want) {
+		t.Errorf("LeadTimes = %v, want %v", got, want)
+	}
+	if got, want := c.CycleTimes(its), []time.Duration{4 * 24 * time.Hour}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CycleTimes = %v, want %v", got, want)
+	}
+
+	// Starting the cycle at "done" by name makes b and c unstarted.
+	c = Config{CycleStart: Boundary{Statuses: []string{"DONE"}}}
+	if got := c.CycleTimes(its); !reflect.DeepEqual(got, []time.Duration{0}) {
+		t.Errorf("CycleTimes starting at done = %v", got)
+	}
+}
+
+func TestWIP(t *testing.T) {
+	got, err := Config{}.WIP(items(), day0, at(6), 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var counts []int
+	for _, s := range got {
+		counts = append(counts, s.Count)
+	}
+	if want := []int{0, 1, 2, 2, 2, 1, 1}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("WIP = %v, want %v", counts, want)
+	}
+
+	for _, step := range []time.Duration{0, -time.Hour} {
+		if _, err := (Config{}).WIP(items(), day0, at(6), step); err == nil {
+			t.Errorf("WIP with step %v succeeded", step)
+		}
+	}
+}
+
+func TestThroughput(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// DST ends on Sunday 5 November 2023, making that week an hour longer.
+	from := time.Date(2023, 10, 30, 0, 0, 0, 0, ny)
+	finished := func(id string, at time.Time) Item {
+		return Item{TaskID: id, Closed: at}
+	}
+	its := []Item{
+		finished("a", time.Date(2023, 11, 5, 23, 30, 0, 0, ny)),  // last minutes of week 1
+		finished("b", time.Date(2023, 11, 6, 0, 30, 0, 0, ny)),   // first hour of week 2
+		finished("c", time.Date(2023, 11, 12, 23, 59, 0, 0, ny)), // end of week 2
+		finished("d", time.Date(2023, 10, 29, 12, 0, 0, 0, ny)),  // before from
+	}
+	got := Config{}.Throughput(its, from, time.Date(2023, 11, 14, 0, 0, 0, 0, ny))
+
+	want := []Sample{
+		{time.Date(2023, 10, 30, 0, 0, 0, 0, ny), 1},
+		{time.Date(2023, 11, 6, 0, 0, 0, 0, ny), 2},
+		{time.Date(2023, 11, 13, 0, 0, 0, 0, ny), 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Throughput = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].At.Equal(want[i].At) || got[i].Count != want[i].Count {
+			t.Errorf("week %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCumulativeFlow(t *testing.T) {
+	cfd, err := CumulativeFlow(items(), day0, at(6), 48*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := cfd.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `date,to do,doing,done
+2023-11-01T09:00:00Z,3,0,0
+2023-11-03T09:00:00Z,1,2,0
+2023-11-05T09:00:00Z,1,2,0
+2023-11-07T09:00:00Z,1,1,1
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if _, err := CumulativeFlow(items(), day0, at(4), 0); err == nil {
+		t.Error("CumulativeFlow with a zero step succeeded")
+	}
+}
+
+func TestDistribute(t *testing.T) {
+	var ds []time.Duration
+	for i := 20; i >= 1; i-- {
+		ds = append(ds, time.Duration(i)*time.Hour)
+	}
+	got := Distribute(ds)
+	want := Distribution{
+		Count: 20,
+		Min:   time.Hour,
+		Max:   20 * time.Hour,
+		Mean:  10*time.Hour + 30*time.Minute,
+		P50:   10 * time.Hour,
+		P85:   17 * time.Hour,
+		P95:   19 * time.Hour,
+	}
+	if got != want {
+		t.Errorf("Distribute = %+v, want %+v", got, want)
+	}
+	if got := Distribute(nil); got != (Distribution{}) {
+		t.Errorf("Distribute(nil) = %+v", got)
+	}
+}
+
+func TestWithClosed(t *testing.T) {
+	tests := []struct{ query, want string }{
+		{"", "?include_closed=true"},
+		{"?", "?include_closed=true"},
+		{"?statuses[]=open", "?statuses[]=open&include_closed=true"},
+		{"statuses[]=open", "?statuses[]=open&include_closed=true"},
+	}
+	for _, tt := range tests {
+		if got := withClosed(tt.query); got != tt.want {
+			t.Errorf("withClosed(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Package oauth implements the ClickUp OAuth2 authorization code flow.
+//
+// Redirect the user to AuthCodeURL, then exchange the code ClickUp sends back
+// to the redirect URL for an access token:
+//
+//	conf := &oauth.Config{ClientID: id, ClientSecret: secret, RedirectURL: redirect}
+//	http.Redirect(w, r, conf.AuthCodeURL(state), http.StatusFound)
+//
+//	// in the redirect handler
+//	tt, err := conf.Exchange(ctx, r.URL.Query().Get("code"))
+//	client := clickup.NewClient(tt.Client())
+//	teams, _, err := client.AuthorizedTeams(ctx)
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+const defaultAuthURL = "https://app.clickup.com/api"
+
+// Config describes a ClickUp OAuth app.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+
+	// AuthURL is the page users are sent to for authorizing the app. It
+	// defaults to https://app.clickup.com/api.
+	AuthURL string
+
+	// BaseURL is the API the code is exchanged at, defaulting to the public
+	// ClickUp v2 API. It should have a trailing slash.
+	BaseURL *url.URL
+
+	// HTTPClient is used to exchange the code, and its Transport is the one
+	// the returned TokenTransport sends requests with. It defaults to a new
+	// http.Client.
+	HTTPClient *http.Client
+}
+
+// AuthCodeURL returns the URL of the page asking the user to authorize the
+// app. state is passed back to the redirect URL and should be checked there
+// to protect against CSRF.
+func (c *Config) AuthCodeURL(state string) string {
+	authURL := c.AuthURL
+	if authURL == "" {
+		authURL = defaultAuthURL
+	}
+
+	params := url.Values{}
+	params.Set("client_id", c.ClientID)
+	params.Set("redirect_uri", c.RedirectURL)
+	if state != "" {
+		params.Set("state", state)
+	}
+	return authURL + "?" + params.Encode()
+}
+
+// Exchange trades an authorization code for an access token and returns a
+// transport that authenticates requests with it. The client secret and code
+// are redacted from any error returned.
+func (c *Config) Exchange(ctx context.Context, code string) (*clickup.TokenTransport, error) {
+	client := clickup.NewClient(c.HTTPClient)
+	if c.BaseURL != nil {
+		client.BaseURL = c.BaseURL
+	}
+
+	params := url.Values{}
+	params.Set("client_id", c.ClientID)
+	params.Set("client_secret", c.ClientSecret)
+	params.Set("code", code)
+	req, err := client.NewRequest("POST", "oauth/token?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	token := new(struct {
+		AccessToken string `json:"access_token"`
+	})
+	if _, err := client.Do(ctx, req, token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("oauth: no access token in response")
+	}
+
+	tt := &clickup.TokenTransport{AccessToken: token.AccessToken}
+	if c.HTTPClient != nil {
+		tt.Transport = c.HTTPClient.Transport
+	}
+	return tt, nil
+}
@@ -0,0 +1,104 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// countingTransport counts the requests sent through it.
+type countingTransport struct {
+	n int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.n++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newServer starts a fake of the OAuth endpoints, which the caller should
+// close, and returns it with a Config using it.
+func newServer(t *testing.T) (*httptest.Server, *Config) {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.Method != http.MethodPost || q.Get("client_id") != "id" || q.Get("client_secret") != "s3cret" {
+			http.Error(w, `{"err":"Client not found","ECODE":"OAUTH_010"}`, http.StatusBadRequest)
+			return
+		}
+		switch q.Get("code") {
+		case "good":
+			fmt.Fprint(w, `{"access_token":"token"}`)
+		case "empty":
+			fmt.Fprint(w, `{}`)
+		default:
+			http.Error(w, `{"err":"Code not found","ECODE":"OAUTH_014"}`, http.StatusBadRequest)
+		}
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, `{"err":"Token invalid","ECODE":"OAUTH_025"}`, http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"user":{"id":1}}`)
+	})
+	srv := httptest.NewServer(mux)
+
+	base, _ := url.Parse(srv.URL + "/")
+	return srv, &Config{ClientID: "id", ClientSecret: "s3cret", BaseURL: base}
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	conf := &Config{ClientID: "id", RedirectURL: "https://example.com/cb"}
+	want := "https://app.clickup.com/api?client_id=id&redirect_uri=https%3A%2F%2Fexample.com%2Fcb&state=xyz"
+	if got := conf.AuthCodeURL("xyz"); got != want {
+		t.Errorf("AuthCodeURL = %s, want %s", got, want)
+	}
+}
+
+func TestExchange(t *testing.T) {
+	srv, conf := newServer(t)
+	defer srv.Close()
+	transport := &countingTransport{}
+	conf.HTTPClient = &http.Client{Transport: transport}
+
+	tt, err := conf.Exchange(context.Background(), "good")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tt.AccessToken != "token" {
+		t.Errorf("AccessToken = %q, want token", tt.AccessToken)
+	}
+
+	resp, err := tt.Client().Get(srv.URL + "/user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GET /user with the token = %s", resp.Status)
+	}
+	if transport.n != 2 {
+		t.Errorf("configured transport sent %d requests, want the exchange and GET /user", transport.n)
+	}
+}
+
+func TestExchangeErrors(t *testing.T) {
+	srv, conf := newServer(t)
+	defer srv.Close()
+	for _, code := range []string{"bad", "empty"} {
+		_, err := conf.Exchange(context.Background(), code)
+		if err == nil {
+			t.Errorf("Exchange(%q) succeeded", code)
+			continue
+		}
+		if strings.Contains(err.Error(), "s3cret") {
+			t.Errorf("Exchange(%q) error leaks the client secret: %v", code, err)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package clickup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// TaskIterator walks every page of a task listing. All page requests share a
+// parent span, so a crawl shows up as a single trace:
+//
+//	it := client.Tasks.ListIter(ctx, listID, "?include_closed=true")
+//	defer it.Close()
+//	for it.Next() {
+//		task := it.Task()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type TaskIterator struct {
+	ctx   context.Context
+	span  Span
+	fetch func(ctx context.Context, query string) (*TasksWrapper, *Response, error)
+	query string
+
+	page  int
+	tasks []Task
+	cur   Task
+	last  bool
+	err   error
+	ended bool
+}
+
+// ListIter returns an iterator over every task of a list. query holds extra
+// filters in the form accepted by List and must not set page.
+func (s *TasksService) ListIter(ctx context.Context, listID string, query string) *TaskIterator {
+	ctx, span := s.client.StartSpan(ctx, "clickup.Tasks.List", Attribute{AttrListID, listID})
+	return &TaskIterator{ctx: ctx, span: span, query: query, fetch: func(ctx context.Context, q string) (*TasksWrapper, *Response, error) {
+		return s.List(ctx, listID, q)
+	}}
+}
+
+// ForTeamIter returns an iterator over every task matching a filtered team
+// query. query holds filters in the form accepted by ForTeam and must not set
+// page.
+func (s *TasksService) ForTeamIter(ctx context.Context, teamID string, query string) *TaskIterator {
+	ctx, span := s.client.StartSpan(ctx, "clickup.Tasks.ForTeam", Attribute{AttrWorkspaceID, teamID})
+	return &TaskIterator{ctx: ctx, span: span, query: query, fetch: func(ctx context.Context, q string) (*TasksWrapper, *Response, error) {
+		return s.ForTeam(ctx, teamID, q)
+	}}
+}
+
+// Next advances to the next task, fetching the next page when needed. It
+// returns false when there are no more tasks, an error occurred or the
+// iterator was closed.
+func (it *TaskIterator) Next() bool {
+	if it.ended {
+		return false
+	}
+	for len(it.tasks) == 0 {
+		if it.last || it.err != nil {
+			it.Close()
+			return false
+		}
+		ts, _, err := it.fetch(it.ctx, withPage(it.query, it.page))
+		if err != nil {
+			it.err = err
+			it.span.RecordError(err)
+			continue
+		}
+		it.page++
+		it.tasks = ts.Tasks
+		it.last = ts.LastPage || len(ts.Tasks) == 0
+	}
+	it.cur, it.tasks = it.tasks[0], it.tasks[1:]
+	return true
+}
+
+// Task returns the current task.
+func (it *TaskIterator) Task() Task {
+	return it.cur
+}
+
+// Page returns the number of pages fetched so far.
+func (it *TaskIterator) Page() int {
+	return it.page
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *TaskIterator) Err() error {
+	return it.err
+}
+
+// Close ends the iteration and its span; Next returns false from then on. It
+// is safe to call more than once.
+func (it *TaskIterator) Close() {
+	if it.ended {
+		return
+	}
+	it.ended = true
+	it.tasks = nil
+	it.span.SetAttributes(Attribute{"clickup.pages", it.page})
+	it.span.End()
+}
+
+// withPage adds the page parameter to a query string.
+func withPage(query string, page int) string {
+	if query == "" || query == "?" {
+		return fmt.Sprintf("?page=%d", page)
+	}
+	if !strings.HasPrefix(query, "?") {
+		query = "?" + query
+	}
+	return fmt.Sprintf("%s&page=%d", query, page)
+}
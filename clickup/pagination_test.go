@@ -0,0 +1,60 @@
+package clickup_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+// setupPages returns a fake server, a client of it and a list of five tasks
+// served two per page.
+func setupPages(t *testing.T) (*clickuptest.Server, *clickup.Client, string) {
+	t.Helper()
+	srv, client, team := setup(t)
+	srv.PageSize = 2
+	list := srv.AddList(srv.AddSpace(team, "Engineering"), "", "Backlog")
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		srv.AddTask(list, clickuptest.Task{Name: name})
+	}
+	return srv, client, list
+}
+
+func TestTaskIterator(t *testing.T) {
+	srv, client, list := setupPages(t)
+	defer srv.Close()
+	ctx := context.Background()
+
+	it := client.Tasks.ListIter(ctx, list, "")
+	var names []string
+	for it.Next() {
+		names = append(names, it.Task().Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 5 || it.Page() != 3 {
+		t.Errorf("iterated %q over %d pages, want 5 tasks over 3 pages", names, it.Page())
+	}
+}
+
+func TestTaskIteratorClose(t *testing.T) {
+	srv, client, list := setupPages(t)
+	defer srv.Close()
+
+	it := client.Tasks.ListIter(context.Background(), list, "")
+	if !it.Next() {
+		t.Fatalf("Next() = false, err %v", it.Err())
+	}
+	it.Close()
+	for i := 0; i < 3; i++ {
+		if it.Next() {
+			t.Fatalf("Next() after Close returned task %q", it.Task().Name)
+		}
+	}
+	if it.Page() != 1 {
+		t.Errorf("fetched %d pages, want only the one before Close", it.Page())
+	}
+	it.Close()
+}
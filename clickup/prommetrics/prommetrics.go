@@ -0,0 +1,222 @@
+// Package prommetrics implements clickup.Metrics and exposes the collected
+// values in the Prometheus text exposition format, without depending on the
+// Prometheus client library.
+//
+//	m := prommetrics.New("clickup")
+//	client := clickup.NewClient(httpClient)
+//	client.Metrics = m
+//	http.Handle("/metrics", m)
+//
+// The following metrics are exposed, prefixed with the namespace:
+//
+//	requests_total{endpoint,method,status_class}            counter
+//	request_duration_seconds{endpoint,method,status_class}  histogram
+//	rate_limit_remaining                                    gauge
+package prommetrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBuckets are the latency histogram buckets, in seconds, used when
+// none are given to New.
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type labels struct {
+	endpoint, method, statusClass string
+}
+
+type histogram struct {
+	counts []uint64 // per bucket, not cumulative
+	sum    float64
+	count  uint64
+}
+
+// Collector collects request metrics from a clickup.Client. It is safe for
+// concurrent use.
+type Collector struct {
+	namespace string
+	buckets   []float64
+
+	mu            sync.Mutex
+	requests      map[labels]uint64
+	latency       map[labels]*histogram
+	rateRemaining int
+	rateSet       bool
+}
+
+// New returns a Collector whose metric names are prefixed with namespace
+// (followed by an underscore, unless namespace is empty). buckets are the
+// upper bounds of the latency histogram in seconds; DefaultBuckets are used
+// when none are given.
+func New(namespace string, buckets ...float64) *Collector {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	b := append([]float64(nil), buckets...)
+	sort.Float64s(b)
+	return &Collector{
+		namespace: namespace,
+		buckets:   b,
+		requests:  make(map[labels]uint64),
+		latency:   make(map[labels]*histogram),
+	}
+}
+
+// IncRequest implements clickup.Metrics.
+func (c *Collector) IncRequest(endpoint, method, statusClass string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests[labels{endpoint, method, statusClass}]++
+}
+
+// ObserveLatency implements clickup.Metrics.
+func (c *Collector) ObserveLatency(endpoint, method, statusClass string, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	l := labels{endpoint, method, statusClass}
+	h, ok := c.latency[l]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(c.buckets))}
+		c.latency[l] = h
+	}
+	v := d.Seconds()
+	for i, ub := range c.buckets {
+		if v <= ub {
+			h.counts[i]++
+			break
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+// SetRateLimitRemaining implements clickup.Metrics.
+func (c *Collector) SetRateLimitRemaining(remaining int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rateRemaining = remaining
+	c.rateSet = true
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text format.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Count the bytes that reach w, not those buffered.
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	name := c.name("requests_total")
+	fmt.Fprintf(bw, "# HELP %s Number of ClickUp API requests.\n", name)
+	fmt.Fprintf(bw, "# TYPE %s counter\n", name)
+	for _, l := range requestLabels(c.requests) {
+		fmt.Fprintf(bw, "%s{%s} %d\n", name, l.format(""), c.requests[l])
+	}
+
+	name = c.name("request_duration_seconds")
+	fmt.Fprintf(bw, "# HELP %s Latency of ClickUp API requests.\n", name)
+	fmt.Fprintf(bw, "# TYPE %s histogram\n", name)
+	for _, l := range latencyLabels(c.latency) {
+		h := c.latency[l]
+		var cumulative uint64
+		for i, ub := range c.buckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(bw, "%s_bucket{%s} %d\n", name, l.format(formatFloat(ub)), cumulative)
+		}
+		fmt.Fprintf(bw, "%s_bucket{%s} %d\n", name, l.format("+Inf"), h.count)
+		fmt.Fprintf(bw, "%s_sum{%s} %s\n", name, l.format(""), formatFloat(h.sum))
+		fmt.Fprintf(bw, "%s_count{%s} %d\n", name, l.format(""), h.count)
+	}
+
+	if c.rateSet {
+		name = c.name("rate_limit_remaining")
+		fmt.Fprintf(bw, "# HELP %s Requests left in the current ClickUp rate limit window.\n", name)
+		fmt.Fprintf(bw, "# TYPE %s gauge\n", name)
+		fmt.Fprintf(bw, "%s %d\n", name, c.rateRemaining)
+	}
+
+	err := bw.Flush()
+	return cw.n, err
+}
+
+func (c *Collector) name(metric string) string {
+	if c.namespace == "" {
+		return metric
+	}
+	return c.namespace + "_" + metric
+}
+
+func (l labels) format(le string) string {
+	s := fmt.Sprintf(`endpoint="%s",method="%s",status_class="%s"`,
+		escape(l.endpoint), escape(l.method), escape(l.statusClass))
+	if le != "" {
+		s += `,le="` + le + `"`
+	}
+	return s
+}
+
+func requestLabels(m map[labels]uint64) []labels {
+	ls := make([]labels, 0, len(m))
+	for l := range m {
+		ls = append(ls, l)
+	}
+	return sortLabels(ls)
+}
+
+func latencyLabels(m map[labels]*histogram) []labels {
+	ls := make([]labels, 0, len(m))
+	for l := range m {
+		ls = append(ls, l)
+	}
+	return sortLabels(ls)
+}
+
+func sortLabels(ls []labels) []labels {
+	sort.Slice(ls, func(i, j int) bool {
+		a, b := ls[i], ls[j]
+		if a.endpoint != b.endpoint {
+			return a.endpoint < b.endpoint
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		return a.statusClass < b.statusClass
+	})
+	return ls
+}
+
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,110 @@
+package prommetrics
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+var _ clickup.Metrics = (*Collector)(nil)
+
+func TestWriteTo(t *testing.T) {
+	c := New("clickup", 1, 0.1)
+	c.IncRequest("task/{id}", "GET", "2xx")
+	c.IncRequest("task/{id}", "GET", "2xx")
+	c.IncRequest(`odd"path`, "PUT", "4xx")
+	c.ObserveLatency("task/{id}", "GET", "2xx", 50*time.Millisecond)
+	c.ObserveLatency("task/{id}", "GET", "2xx", 500*time.Millisecond)
+	c.ObserveLatency("task/{id}", "GET", "2xx", 2*time.Second)
+	c.SetRateLimitRemaining(97)
+
+	var buf bytes.Buffer
+	n, err := c.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `# HELP clickup_requests_total Number of ClickUp API requests.
+# TYPE clickup_requests_total counter
+clickup_requests_total{endpoint="odd\"path",method="PUT",status_class="4xx"} 1
+clickup_requests_total{endpoint="task/{id}",method="GET",status_class="2xx"} 2
+# HELP clickup_request_duration_seconds Latency of ClickUp API requests.
+# TYPE clickup_request_duration_seconds histogram
+clickup_request_duration_seconds_bucket{endpoint="task/{id}",method="GET",status_class="2xx",le="0.1"} 1
+clickup_request_duration_seconds_bucket{endpoint="task/{id}",method="GET",status_class="2xx",le="1"} 2
+clickup_request_duration_seconds_bucket{endpoint="task/{id}",method="GET",status_class="2xx",le="+Inf"} 3
+clickup_request_duration_seconds_sum{endpoint="task/{id}",method="GET",status_class="2xx"} 2.55
+clickup_request_duration_seconds_count{endpoint="task/{id}",method="GET",status_class="2xx"} 3
+# HELP clickup_rate_limit_remaining Requests left in the current ClickUp rate limit window.
+# TYPE clickup_rate_limit_remaining gauge
+clickup_rate_limit_remaining 97
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("WriteTo returned %d, wrote %d bytes", n, buf.Len())
+	}
+}
+
+func TestWriteToEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := New("").WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `# HELP requests_total Number of ClickUp API requests.
+# TYPE requests_total counter
+# HELP request_duration_seconds Latency of ClickUp API requests.
+# TYPE request_duration_seconds histogram
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+// shortWriter accepts up to n bytes.
+type shortWriter struct {
+	n int
+}
+
+func (w *shortWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		n := w.n
+		w.n = 0
+		return n, errors.New("short write")
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func TestWriteToError(t *testing.T) {
+	c := New("clickup")
+	for i := 0; i < 200; i++ {
+		c.ObserveLatency("task/{id}", "GET", "2xx", time.Duration(i)*time.Millisecond)
+		c.IncRequest("task/{id}", "GET", "2xx")
+	}
+	n, err := c.WriteTo(&shortWriter{n: 10})
+	if err == nil {
+		t.Fatal("WriteTo to a failing writer succeeded")
+	}
+	if n != 10 {
+		t.Errorf("WriteTo returned %d, want the 10 bytes the writer accepted", n)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	c := New("clickup")
+	c.SetRateLimitRemaining(3)
+	rec := httptest.NewRecorder()
+	c.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != "text/plain; version=0.0.4; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte("\nclickup_rate_limit_remaining 3\n")) {
+		t.Errorf("body lacks the rate limit gauge:\n%s", rec.Body)
+	}
+}
@@ -0,0 +1,32 @@
+package clickup
+
+import (
+	"regexp"
+)
+
+const redacted = "REDACTED"
+
+var redactions = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	// Authorization headers as printed by %v of an http.Header or a request
+	// dump, keeping the scheme of bearer tokens.
+	{regexp.MustCompile(`(?i)(authorization["']?\s*[:=]\s*\[?\s*["']?)(bearer\s+)?[^\s"'\],}]+`), "${1}${2}" + redacted},
+	// ClickUp personal API tokens, pk_ followed by the user ID and the key,
+	// but not identifiers merely containing pk_ such as task_pk_id.
+	{regexp.MustCompile(`\bpk_[0-9]+_[A-Z0-9]+`), "pk_" + redacted},
+	// OAuth secrets in query strings and JSON bodies.
+	{regexp.MustCompile(`(?i)((?:client_secret|access_token)["']?\s*[:=]\s*["']?)[^\s"'&,}]+`), "${1}" + redacted},
+}
+
+// Redact removes credentials from s: the values of Authorization headers,
+// ClickUp personal tokens (pk_...), OAuth client secrets and access tokens.
+// It is applied to error messages and Stringify output, and should be used by
+// anything logging requests or responses.
+func Redact(s string) string {
+	for _, r := range redactions {
+		s = r.re.ReplaceAllString(s, r.repl)
+	}
+	return s
+}
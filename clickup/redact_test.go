@@ -0,0 +1,164 @@
+package clickup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const (
+	testPersonalToken = "pk_12345_ABCDEFGHIJKLMNOP"
+	testAccessToken   = "123456_0123456789abcdef"
+	testClientSecret  = "s3cr3tClientSecret"
+	testCode          = "authCode987"
+)
+
+var testSecrets = []string{testPersonalToken, testAccessToken, testClientSecret, testCode}
+
+func assertNoSecrets(t *testing.T, s string) {
+	t.Helper()
+	for _, secret := range testSecrets {
+		if strings.Contains(s, secret) {
+			t.Errorf("%q leaks secret %q", s, secret)
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"token " + testPersonalToken, "token pk_REDACTED"},
+		{"Authorization: " + testAccessToken, "Authorization: REDACTED"},
+		{"Authorization: Bearer " + testAccessToken, "Authorization: Bearer REDACTED"},
+		{"map[Authorization:[" + testAccessToken + "] User-Agent:[go-clickup]]", "map[Authorization:[REDACTED] User-Agent:[go-clickup]]"},
+		{`{"authorization":"` + testAccessToken + `"}`, `{"authorization":"REDACTED"}`},
+		{`{"access_token":"` + testAccessToken + `"}`, `{"access_token":"REDACTED"}`},
+		{"client_id=1&client_secret=" + testClientSecret + "&code=x", "client_id=1&client_secret=REDACTED&code=x"},
+		{"task_pk_id=7 pk_test", "task_pk_id=7 pk_test"},
+		{"nothing to see", "nothing to see"},
+	}
+	for _, tt := range tests {
+		if got := Redact(tt.in); got != tt.want {
+			t.Errorf("Redact(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSanitizeURL(t *testing.T) {
+	u, _ := url.Parse("https://api.clickup.com/api/v2/oauth/token?client_id=1&client_secret=" + testClientSecret + "&code=" + testCode)
+	got := sanitizeURL(u).String()
+	assertNoSecrets(t, got)
+	if !strings.Contains(u.String(), testClientSecret) {
+		t.Errorf("sanitizeURL modified its argument: %v", u)
+	}
+}
+
+func TestPersonalTokenTransport_setsAuthorization(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer srv.Close()
+
+	tp := &PersonalTokenTransport{PersonalToken: testPersonalToken}
+	resp, err := tp.Client().Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got != testPersonalToken {
+		t.Errorf("Authorization header = %q, want %q", got, testPersonalToken)
+	}
+}
+
+func TestTokenTransport_setsBearer(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer srv.Close()
+
+	tp := &TokenTransport{AccessToken: testAccessToken}
+	resp, err := tp.Client().Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if want := "Bearer " + testAccessToken; got != want {
+		t.Errorf("Authorization header = %q, want %q", got, want)
+	}
+}
+
+func TestTransports_printing(t *testing.T) {
+	pt := PersonalTokenTransport{PersonalToken: testPersonalToken}
+	tt := TokenTransport{AccessToken: testAccessToken}
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		assertNoSecrets(t, fmt.Sprintf(format, pt))
+		assertNoSecrets(t, fmt.Sprintf(format, &pt))
+		assertNoSecrets(t, fmt.Sprintf(format, tt))
+		assertNoSecrets(t, fmt.Sprintf(format, &tt))
+	}
+}
+
+func TestErrors_noSecrets(t *testing.T) {
+	body := `{"err":"Token invalid: ` + testPersonalToken + `","message":"bad token ` + testPersonalToken + `","errors":[{"resource":"Authorization: ` + testAccessToken + `","field":"f","code":"c"}]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, body)
+	}))
+	defer srv.Close()
+
+	tp := &PersonalTokenTransport{PersonalToken: testPersonalToken}
+	client := NewClient(tp.Client())
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	req, err := client.NewRequest("POST", "oauth/token?client_secret="+testClientSecret+"&code="+testCode, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Do(context.Background(), req, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	assertNoSecrets(t, err.Error())
+	assertNoSecrets(t, fmt.Sprintf("%+v", err))
+	if er, ok := err.(*ErrorResponse); ok {
+		for _, e := range er.Errors {
+			assertNoSecrets(t, e.Error())
+		}
+	} else {
+		t.Errorf("error is %T, want *ErrorResponse", err)
+	}
+
+	// Transport errors carry the URL too.
+	client.BaseURL, _ = url.Parse("http://127.0.0.1:1/")
+	req, err = client.NewRequest("POST", "oauth/token?client_secret="+testClientSecret+"&code="+testCode, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Do(context.Background(), req, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	assertNoSecrets(t, err.Error())
+}
+
+func TestStringify_noSecrets(t *testing.T) {
+	v := struct {
+		Header http.Header
+		Token  string
+	}{
+		Header: http.Header{"Authorization": {testAccessToken}},
+		Token:  testPersonalToken,
+	}
+	assertNoSecrets(t, Stringify(v))
+}
@@ -0,0 +1,46 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Fetch returns the time entries that started in [start, end) for the given
+// assignees, with location names included. With no assignees the entries of
+// every member of the workspace are fetched, which requires the token to
+// belong to a workspace owner or admin.
+func Fetch(ctx context.Context, c *clickup.Client, teamID string, start, end time.Time, assignees []int64) ([]clickup.TimeEntry, error) {
+	if len(assignees) == 0 {
+		teams, _, err := c.Workspaces.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, team := range teams.Workspaces {
+			if team.ID != teamID {
+				continue
+			}
+			found = true
+			for _, m := range team.Members {
+				assignees = append(assignees, m.User.ID)
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("reports: workspace %s not found", teamID)
+		}
+	}
+
+	entries, _, err := c.TimeTracking.ListEntries(ctx, teamID, &clickup.TimeEntryOptions{
+		StartDate:            start,
+		EndDate:              end,
+		Assignee:             assignees,
+		IncludeLocationNames: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries.TimeEntries, nil
+}
@@ -0,0 +1,82 @@
+package reports
+
+import (
+	"context"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Location is where a list lives in the workspace hierarchy.
+type Location struct {
+	ListID     string
+	ListName   string
+	FolderID   string
+	FolderName string
+	SpaceID    string
+	SpaceName  string
+}
+
+// Locations maps list IDs to their location.
+type Locations map[string]Location
+
+// LoadLocations walks the spaces and folders of a workspace and records the
+// location of every list in it, including folderless lists.
+func LoadLocations(ctx context.Context, c *clickup.Client, teamID string) (Locations, error) {
+	spaces, _, err := c.Spaces.List(ctx, teamID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	locs := make(Locations)
+	for _, space := range spaces.Spaces {
+		folders, _, err := c.Folders.List(ctx, space.ID, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, folder := range folders.Folders {
+			for _, list := range folder.Lists {
+				locs[list.ID] = Location{
+					ListID:     list.ID,
+					ListName:   list.Name,
+					FolderID:   folder.ID,
+					FolderName: folder.Name,
+					SpaceID:    space.ID,
+					SpaceName:  space.Name,
+				}
+			}
+		}
+
+		lists, _, err := c.Lists.GetFolderlessLists(ctx, space.ID, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, list := range lists.Lists {
+			locs[list.ID] = Location{
+				ListID:    list.ID,
+				ListName:  list.Name,
+				SpaceID:   space.ID,
+				SpaceName: space.Name,
+			}
+		}
+	}
+	return locs, nil
+}
+
+// fill returns l with its empty fields taken from known.
+func (l Location) fill(known Location) Location {
+	for _, f := range []struct {
+		dst *string
+		src string
+	}{
+		{&l.ListName, known.ListName},
+		{&l.FolderID, known.FolderID},
+		{&l.FolderName, known.FolderName},
+		{&l.SpaceID, known.SpaceID},
+		{&l.SpaceName, known.SpaceName},
+	} {
+		if *f.dst == "" {
+			*f.dst = f.src
+		}
+	}
+	return l
+}
@@ -0,0 +1,114 @@
+package reports
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// WriteCSV writes the report as CSV with a header row. There are columns for
+// each grouped dimension, in GroupBy order, followed by hours and entries.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	var header []string
+	for _, dim := range r.GroupBy {
+		header = append(header, columns(dim)...)
+	}
+	header = append(header, "hours", "entries")
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, row := range r.Rows {
+		var record []string
+		for _, dim := range r.GroupBy {
+			record = append(record, row.fields(dim)...)
+		}
+		record = append(record, formatHours(row.Duration), strconv.Itoa(row.Entries))
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+type jsonRow struct {
+	Key
+	Hours      float64 `json:"hours"`
+	DurationMS int64   `json:"duration_ms"`
+	Entries    int     `json:"entries"`
+}
+
+// WriteJSON writes the report as a single JSON document.
+func (r *Report) WriteJSON(w io.Writer) error {
+	doc := struct {
+		Start      *time.Time  `json:"start,omitempty"`
+		End        *time.Time  `json:"end,omitempty"`
+		GroupBy    []Dimension `json:"group_by"`
+		TotalHours float64     `json:"total_hours"`
+		Rows       []jsonRow   `json:"rows"`
+	}{
+		GroupBy:    r.GroupBy,
+		TotalHours: hours(r.Total),
+		Rows:       make([]jsonRow, len(r.Rows)),
+	}
+	if !r.Start.IsZero() {
+		doc.Start = &r.Start
+	}
+	if !r.End.IsZero() {
+		doc.End = &r.End
+	}
+	for i, row := range r.Rows {
+		doc.Rows[i] = jsonRow{
+			Key:        row.Key,
+			Hours:      hours(row.Duration),
+			DurationMS: row.Duration.Milliseconds(),
+			Entries:    row.Entries,
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+func columns(dim Dimension) []string {
+	switch dim {
+	case ByTag, ByBillable:
+		return []string{string(dim)}
+	default:
+		return []string{string(dim) + "_id", string(dim)}
+	}
+}
+
+func (r Row) fields(dim Dimension) []string {
+	switch dim {
+	case ByUser:
+		return []string{strconv.FormatInt(r.UserID, 10), r.Username}
+	case ByTask:
+		return []string{r.TaskID, r.TaskName}
+	case ByList:
+		return []string{r.ListID, r.ListName}
+	case ByFolder:
+		return []string{r.FolderID, r.FolderName}
+	case BySpace:
+		return []string{r.SpaceID, r.SpaceName}
+	default:
+		return []string{r.value(dim)}
+	}
+}
+
+// hours converts d to hours with two decimals.
+func hours(d time.Duration) float64 {
+	v, _ := strconv.ParseFloat(formatHours(d), 64)
+	return v
+}
+
+func formatHours(d time.Duration) string {
+	return strconv.FormatFloat(d.Hours(), 'f', 2, 64)
+}
@@ -0,0 +1,286 @@
+// Package reports builds timesheet reports from ClickUp time entries,
+// aggregating tracked time by user, task, list, folder, space, tag and
+// billable flag.
+//
+// Fetching and aggregation are separate so reports can be generated from
+// entries decoded from fixture data as easily as from the API:
+//
+//	entries, _ := reports.Fetch(ctx, client, teamID, start, end, nil)
+//	locs, _ := reports.LoadLocations(ctx, client, teamID)
+//	r := reports.Generate(entries, locs, reports.Options{
+//		Start:    start,
+//		End:      end,
+//		GroupBy:  []reports.Dimension{reports.ByUser, reports.BySpace},
+//		Rounding: reports.Rounding{Increment: 15 * time.Minute, Mode: reports.RoundUp, PerEntry: true},
+//	})
+//	r.WriteCSV(os.Stdout)
+package reports
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// Dimension is a property time entries can be grouped by.
+type Dimension string
+
+const (
+	ByUser     Dimension = "user"
+	ByTask     Dimension = "task"
+	ByList     Dimension = "list"
+	ByFolder   Dimension = "folder"
+	BySpace    Dimension = "space"
+	ByTag      Dimension = "tag" // tags of the time entry, not of its task
+	ByBillable Dimension = "billable"
+)
+
+// RoundingMode is the direction durations are rounded in.
+type RoundingMode int
+
+const (
+	RoundNearest RoundingMode = iota
+	RoundUp
+	RoundDown
+)
+
+// Rounding rounds durations to a multiple of Increment. A zero Increment
+// disables rounding. With PerEntry every time entry is rounded before it is
+// added to a row, otherwise the totals of each row are rounded.
+type Rounding struct {
+	Increment time.Duration
+	Mode      RoundingMode
+	PerEntry  bool
+}
+
+// Round applies the rounding rule to d.
+func (r Rounding) Round(d time.Duration) time.Duration {
+	if r.Increment <= 0 {
+		return d
+	}
+	switch r.Mode {
+	case RoundUp:
+		if rem := d % r.Increment; rem != 0 {
+			return d - rem + r.Increment
+		}
+		return d
+	case RoundDown:
+		return d - d%r.Increment
+	default:
+		return d.Round(r.Increment)
+	}
+}
+
+// Options controls how a report is generated.
+type Options struct {
+	// Start and End limit the report to entries starting in [Start, End).
+	// A zero value leaves that side of the range open.
+	Start time.Time
+	End   time.Time
+
+	// GroupBy lists the dimensions rows are keyed by, in column order. An
+	// empty GroupBy produces a single total row.
+	GroupBy []Dimension
+
+	Rounding Rounding
+
+	// IncludeRunning counts running timers up to Now. By default they are
+	// left out since their duration is not final.
+	IncludeRunning bool
+	Now            time.Time
+}
+
+// Key identifies a row. Only the fields of the grouped dimensions are set.
+type Key struct {
+	UserID     int64  `json:"user_id,omitempty"`
+	Username   string `json:"username,omitempty"`
+	TaskID     string `json:"task_id,omitempty"`
+	TaskName   string `json:"task_name,omitempty"`
+	ListID     string `json:"list_id,omitempty"`
+	ListName   string `json:"list_name,omitempty"`
+	FolderID   string `json:"folder_id,omitempty"`
+	FolderName string `json:"folder_name,omitempty"`
+	SpaceID    string `json:"space_id,omitempty"`
+	SpaceName  string `json:"space_name,omitempty"`
+	Tag        string `json:"tag,omitempty"`
+	Billable   *bool  `json:"billable,omitempty"`
+}
+
+// Row is the aggregated time of all entries sharing a Key.
+type Row struct {
+	Key
+	Duration time.Duration
+	Entries  int
+}
+
+// Hours returns the duration of the row in hours.
+func (r Row) Hours() float64 {
+	return r.Duration.Hours()
+}
+
+// Report is the result of Generate.
+type Report struct {
+	Start   time.Time
+	End     time.Time
+	GroupBy []Dimension
+	Rows    []Row
+
+	// Total is the time of all entries in the report. When grouping by tag
+	// an entry with several tags appears in several rows, so Total can be
+	// less than the sum of the rows.
+	Total time.Duration
+}
+
+// Generate aggregates entries into a report. Location names missing from an
+// entry (when it was fetched without include_location_names) are looked up
+// in locs by list ID; locs may be nil.
+func Generate(entries []clickup.TimeEntry, locs Locations, opts Options) *Report {
+	r := &Report{Start: opts.Start, End: opts.End, GroupBy: opts.GroupBy}
+	rows := make(map[string]*Row)
+	var order []string
+
+	for i := range entries {
+		e := &entries[i]
+		if !opts.Start.IsZero() && e.Start.Before(opts.Start) {
+			continue
+		}
+		if !opts.End.IsZero() && !e.Start.Before(opts.End) {
+			continue
+		}
+
+		d := e.Duration
+		if d < 0 {
+			if !opts.IncludeRunning {
+				continue
+			}
+			d = e.Elapsed(opts.Now)
+		}
+		if opts.Rounding.PerEntry {
+			d = opts.Rounding.Round(d)
+		}
+		r.Total += d
+
+		for _, k := range keys(e, locs, opts.GroupBy) {
+			id := k.id()
+			row, ok := rows[id]
+			if !ok {
+				row = &Row{Key: k}
+				rows[id] = row
+				order = append(order, id)
+			}
+			row.Duration += d
+			row.Entries++
+		}
+	}
+
+	if !opts.Rounding.PerEntry {
+		r.Total = opts.Rounding.Round(r.Total)
+	}
+	r.Rows = make([]Row, 0, len(order))
+	for _, id := range order {
+		row := *rows[id]
+		if !opts.Rounding.PerEntry {
+			row.Duration = opts.Rounding.Round(row.Duration)
+		}
+		r.Rows = append(r.Rows, row)
+	}
+	sort.SliceStable(r.Rows, func(i, j int) bool {
+		return r.Rows[i].less(&r.Rows[j], r.GroupBy)
+	})
+	return r
+}
+
+// keys returns the keys e counts towards; there is more than one when
+// grouping by tag and e has several tags.
+func keys(e *clickup.TimeEntry, locs Locations, groupBy []Dimension) []Key {
+	loc := Location{
+		ListID:     e.TaskLocation.ListID,
+		ListName:   e.TaskLocation.ListName,
+		FolderID:   e.TaskLocation.FolderID,
+		FolderName: e.TaskLocation.FolderName,
+		SpaceID:    e.TaskLocation.SpaceID,
+		SpaceName:  e.TaskLocation.SpaceName,
+	}
+	if known, ok := locs[loc.ListID]; ok {
+		loc = loc.fill(known)
+	}
+
+	k := Key{}
+	tags := []string{""}
+	for _, dim := range groupBy {
+		switch dim {
+		case ByUser:
+			k.UserID, k.Username = e.User.ID, e.User.Username
+		case ByTask:
+			if e.Task != nil {
+				k.TaskID, k.TaskName = e.Task.ID, e.Task.Name
+			}
+		case ByList:
+			k.ListID, k.ListName = loc.ListID, loc.ListName
+		case ByFolder:
+			k.FolderID, k.FolderName = loc.FolderID, loc.FolderName
+		case BySpace:
+			k.SpaceID, k.SpaceName = loc.SpaceID, loc.SpaceName
+		case ByBillable:
+			k.Billable = clickup.Bool(e.Billable)
+		case ByTag:
+			if len(e.Tags) > 0 {
+				tags = tags[:0]
+				for _, t := range e.Tags {
+					tags = append(tags, t.Name)
+				}
+			}
+		}
+	}
+
+	result := make([]Key, len(tags))
+	for i, tag := range tags {
+		result[i] = k
+		result[i].Tag = tag
+	}
+	return result
+}
+
+// id returns a string uniquely identifying the key.
+func (k Key) id() string {
+	billable := ""
+	if k.Billable != nil {
+		billable = strconv.FormatBool(*k.Billable)
+	}
+	return strconv.FormatInt(k.UserID, 10) + "\x00" + k.TaskID + "\x00" + k.ListID + "\x00" +
+		k.FolderID + "\x00" + k.SpaceID + "\x00" + k.Tag + "\x00" + billable
+}
+
+// value returns the display value of a dimension of the key.
+func (k Key) value(dim Dimension) string {
+	switch dim {
+	case ByUser:
+		return k.Username
+	case ByTask:
+		return k.TaskName
+	case ByList:
+		return k.ListName
+	case ByFolder:
+		return k.FolderName
+	case BySpace:
+		return k.SpaceName
+	case ByTag:
+		return k.Tag
+	case ByBillable:
+		if k.Billable != nil {
+			return strconv.FormatBool(*k.Billable)
+		}
+	}
+	return ""
+}
+
+func (r *Row) less(o *Row, groupBy []Dimension) bool {
+	for _, dim := range groupBy {
+		if a, b := r.value(dim), o.value(dim); a != b {
+			return a < b
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package reports
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+var (
+	start = time.Unix(1700000000, 0)
+	end   = start.Add(24 * time.Hour)
+
+	// locs knows where the folderless list l2 lives; e2 in the fixture was
+	// fetched without location names.
+	locs = Locations{"l2": {ListID: "l2", ListName: "Backlog", SpaceID: "s1", SpaceName: "Engineering"}}
+)
+
+func loadEntries(t *testing.T) []clickup.TimeEntry {
+	t.Helper()
+	data, err := ioutil.ReadFile("testdata/time_entries.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var w clickup.TimeEntriesWrapper
+	if err := json.Unmarshal(data, &w); err != nil {
+		t.Fatal(err)
+	}
+	return w.TimeEntries
+}
+
+// rows formats the rows of r as "value/value=duration x entries".
+func rows(r *Report) []string {
+	var got []string
+	for _, row := range r.Rows {
+		var values []string
+		for _, dim := range r.GroupBy {
+			values = append(values, row.value(dim))
+		}
+		got = append(got, strings.Join(values, "/")+"="+row.Duration.String()+" x "+strconv.Itoa(row.Entries))
+	}
+	return got
+}
+
+func TestGenerate(t *testing.T) {
+	entries := loadEntries(t)
+	tests := []struct {
+		groupBy []Dimension
+		want    []string
+	}{
+		{nil, []string{"=1h50m0s x 3"}},
+		{[]Dimension{ByUser}, []string{"alice=1h20m0s x 2", "bob=30m0s x 1"}},
+		{[]Dimension{ByTask}, []string{"Docs=50m0s x 2", "Login=1h0m0s x 1"}},
+		{[]Dimension{ByList}, []string{"Backlog=50m0s x 2", "Sprint 1=1h0m0s x 1"}},
+		{[]Dimension{ByFolder}, []string{"=50m0s x 2", "Sprints=1h0m0s x 1"}},
+		{[]Dimension{BySpace}, []string{"Engineering=1h50m0s x 3"}},
+		{[]Dimension{ByTag}, []string{"=30m0s x 1", "design=1h0m0s x 1", "review=1h20m0s x 2"}},
+		{[]Dimension{ByBillable}, []string{"false=50m0s x 2", "true=1h0m0s x 1"}},
+		{[]Dimension{ByUser, ByBillable}, []string{"alice/false=20m0s x 1", "alice/true=1h0m0s x 1", "bob/false=30m0s x 1"}},
+	}
+	for _, tt := range tests {
+		var names []string
+		for _, dim := range tt.groupBy {
+			names = append(names, string(dim))
+		}
+		t.Run(strings.Join(names, "+"), func(t *testing.T) {
+			r := Generate(entries, locs, Options{Start: start, End: end, GroupBy: tt.groupBy})
+			if got := rows(r); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rows = %q, want %q", got, tt.want)
+			}
+			if r.Total != 110*time.Minute {
+				t.Errorf("Total = %v, want 1h50m", r.Total)
+			}
+		})
+	}
+}
+
+func TestGenerateOptions(t *testing.T) {
+	entries := loadEntries(t)
+	tests := []struct {
+		name  string
+		opts  Options
+		want  []string
+		total time.Duration
+	}{
+		{
+			"round each entry up",
+			Options{GroupBy: []Dimension{ByUser}, Rounding: Rounding{Increment: time.Hour, Mode: RoundUp, PerEntry: true}},
+			[]string{"alice=3h0m0s x 3", "bob=1h0m0s x 1"},
+			4 * time.Hour,
+		},
+		{
+			"round totals down",
+			Options{GroupBy: []Dimension{ByUser}, Rounding: Rounding{Increment: time.Hour, Mode: RoundDown}},
+			[]string{"alice=2h0m0s x 3", "bob=0s x 1"},
+			2 * time.Hour,
+		},
+		{
+			"running timer",
+			Options{Start: start, GroupBy: []Dimension{ByUser}, IncludeRunning: true, Now: start.Add(3*time.Hour + 15*time.Minute)},
+			[]string{"alice=1h20m0s x 2", "bob=45m0s x 2"},
+			125 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Generate(entries, locs, tt.opts)
+			if got := rows(r); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rows = %q, want %q", got, tt.want)
+			}
+			if r.Total != tt.total {
+				t.Errorf("Total = %v, want %v", r.Total, tt.total)
+			}
+		})
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	r := Generate(loadEntries(t), locs, Options{Start: start, End: end, GroupBy: []Dimension{BySpace, ByTag}})
+	var buf bytes.Buffer
+	if err := r.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `space_id,space,tag,hours,entries
+s1,Engineering,,0.50,1
+s1,Engineering,design,1.00,1
+s1,Engineering,review,1.33,2
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestFetchUnknownWorkspace(t *testing.T) {
+	srv := clickuptest.NewServer()
+	defer srv.Close()
+	srv.AddWorkspace("Acme")
+
+	if _, err := Fetch(context.Background(), srv.Client(), "nope", start, end, nil); err == nil {
+		t.Error("Fetch of a workspace missing from the teams list succeeded")
+	}
+}
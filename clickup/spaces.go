@@ -3,6 +3,8 @@ package clickup
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
 )
 
 type SpacesService service
@@ -22,9 +24,9 @@ type Space struct {
 		ID         string `json:"id"`
 		Status     string `json:"status"`
 		Type       string `json:"type"`
-		OrderIndex int64  `json:"order_index"`
+		OrderIndex int64  `json:"orderindex"`
 		Color      string `json:"color"`
-	} `json:"statues"`
+	} `json:"statuses"`
 	MultipleAssignees bool                   `json:"multiple_assignees"`
 	Features          map[string]interface{} `json:"features"` // Most are bools but priorities also had more detail so I'll need a better way of marshaling this
 	Archived          bool                   `json:"archived"`
@@ -46,7 +48,28 @@ type Tag struct {
 	Name            string `json:"name"`
 	ForegroundColor string `json:"tag_fg"`
 	BackgroundColor string `json:"tag_bg"`
-	Creator         int64  `json:"creator"`
+	Creator         int64  `json:"creator,omitempty"`
+}
+
+type tagRequest struct {
+	Tag Tag `json:"tag"`
+}
+
+// editTagRequest is the body of Edit Space Tag, which names the colors
+// differently from the other tag endpoints.
+type editTagRequest struct {
+	Tag struct {
+		Name            string `json:"name"`
+		ForegroundColor string `json:"fg_color"`
+		BackgroundColor string `json:"bg_color"`
+	} `json:"tag"`
+}
+
+// TagSyncResult reports the changes SyncTags applied to a space.
+type TagSyncResult struct {
+	Created []Tag
+	Updated []Tag
+	Deleted []Tag
 }
 
 func (s *SpacesService) Get(ctx context.Context, spaceID string, query string) (*Space, *Response, error) {
@@ -55,8 +78,6 @@ func (s *SpacesService) Get(ctx context.Context, spaceID string, query string) (
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(Space)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -72,8 +93,6 @@ func (s *SpacesService) List(ctx context.Context, workspaceID string, query stri
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(SpacesWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -89,8 +108,6 @@ func (s *SpacesService) Tags(ctx context.Context, spaceID string, query string)
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(TagsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -106,8 +123,6 @@ func (s *SpacesService) Views(ctx context.Context, spaceID string, query string)
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(ViewsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -116,3 +131,92 @@ func (s *SpacesService) Views(ctx context.Context, spaceID string, query string)
 
 	return wResp, resp, nil
 }
+
+func (s *SpacesService) CreateTag(ctx context.Context, spaceID string, tag Tag) (*Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("space/%s/tag", spaceID), &tagRequest{Tag: tag})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// EditTag renames or recolors the tag currently named tagName.
+func (s *SpacesService) EditTag(ctx context.Context, spaceID string, tagName string, tag Tag) (*Response, error) {
+	body := new(editTagRequest)
+	body.Tag.Name = tag.Name
+	body.Tag.ForegroundColor = tag.ForegroundColor
+	body.Tag.BackgroundColor = tag.BackgroundColor
+	req, err := s.client.NewRequest("PUT", fmt.Sprintf("space/%s/tag/%s", spaceID, url.PathEscape(tagName)), body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *SpacesService) DeleteTag(ctx context.Context, spaceID string, tagName string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("space/%s/tag/%s", spaceID, url.PathEscape(tagName)), &tagRequest{Tag: Tag{Name: tagName}})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// SyncTags makes the tags of a space match desired. Tags are matched by name,
+// ignoring case as ClickUp does; tags whose colors or letter case differ are
+// edited, missing tags are created and tags not in desired are deleted. Only
+// the first of the desired tags with the same name is used. The changes
+// applied before any error are reported in the result.
+func (s *SpacesService) SyncTags(ctx context.Context, spaceID string, desired []Tag) (*TagSyncResult, *Response, error) {
+	current, resp, err := s.Tags(ctx, spaceID, "")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	existing := make(map[string]Tag, len(current.Tags))
+	for _, t := range current.Tags {
+		existing[strings.ToLower(t.Name)] = t
+	}
+
+	result := new(TagSyncResult)
+	wanted := make(map[string]bool, len(desired))
+	for _, t := range desired {
+		key := strings.ToLower(t.Name)
+		if wanted[key] {
+			continue
+		}
+		wanted[key] = true
+		have, ok := existing[key]
+		switch {
+		case !ok:
+			resp, err = s.CreateTag(ctx, spaceID, t)
+			if err != nil {
+				return result, resp, err
+			}
+			result.Created = append(result.Created, t)
+		case have.Name != t.Name ||
+			!strings.EqualFold(have.ForegroundColor, t.ForegroundColor) ||
+			!strings.EqualFold(have.BackgroundColor, t.BackgroundColor):
+			resp, err = s.EditTag(ctx, spaceID, have.Name, t)
+			if err != nil {
+				return result, resp, err
+			}
+			result.Updated = append(result.Updated, t)
+		}
+	}
+
+	for _, t := range current.Tags {
+		if wanted[strings.ToLower(t.Name)] {
+			continue
+		}
+		resp, err = s.DeleteTag(ctx, spaceID, t.Name)
+		if err != nil {
+			return result, resp, err
+		}
+		result.Deleted = append(result.Deleted, t)
+	}
+
+	return result, resp, nil
+}
@@ -0,0 +1,122 @@
+package clickup_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+func tagNames(tags []clickup.Tag) []string {
+	var names []string
+	for _, t := range tags {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+func TestSpaceTags(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	space := srv.AddSpace(team, "Engineering")
+	ctx := context.Background()
+
+	for _, tag := range []clickup.Tag{
+		{Name: "Bug", ForegroundColor: "#ffffff", BackgroundColor: "#ff0000"},
+		{Name: "feature", ForegroundColor: "#ffffff", BackgroundColor: "#00ff00"},
+	} {
+		if _, err := client.Spaces.CreateTag(ctx, space, tag); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := client.Spaces.EditTag(ctx, space, "Bug", clickup.Tag{Name: "Bug", ForegroundColor: "#000000", BackgroundColor: "#0000ff"}); err != nil {
+		t.Fatal(err)
+	}
+	tags, _, err := client.Spaces.Tags(ctx, space, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bug := tags.Tags[0]; bug.ForegroundColor != "#000000" || bug.BackgroundColor != "#0000ff" {
+		t.Errorf("edited tag = %+v", bug)
+	}
+
+	result, _, err := client.Spaces.SyncTags(ctx, space, []clickup.Tag{
+		{Name: "bug", ForegroundColor: "#000000", BackgroundColor: "#0000FF"},
+		{Name: "Docs", ForegroundColor: "#000000", BackgroundColor: "#cccccc"},
+		{Name: "docs", ForegroundColor: "#ffffff", BackgroundColor: "#333333"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tagNames(result.Updated); len(got) != 1 || got[0] != "bug" {
+		t.Errorf("updated %q, want the case change of Bug", got)
+	}
+	if got := tagNames(result.Created); len(got) != 1 || got[0] != "Docs" {
+		t.Errorf("created %q, want Docs once", got)
+	}
+	if got := tagNames(result.Deleted); len(got) != 1 || got[0] != "feature" {
+		t.Errorf("deleted %q, want feature", got)
+	}
+	tags, _, err = client.Spaces.Tags(ctx, space, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tagNames(tags.Tags); len(got) != 2 || got[0] != "bug" || got[1] != "Docs" {
+		t.Errorf("tags after sync = %q", got)
+	}
+
+	// Syncing again changes nothing.
+	result, _, err = client.Spaces.SyncTags(ctx, space, tags.Tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Created)+len(result.Updated)+len(result.Deleted) != 0 {
+		t.Errorf("second sync = %+v", result)
+	}
+
+	if _, err := client.Spaces.DeleteTag(ctx, space, "Docs"); err != nil {
+		t.Fatal(err)
+	}
+	tags, _, err = client.Spaces.Tags(ctx, space, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tagNames(tags.Tags); len(got) != 1 {
+		t.Errorf("tags after delete = %q", got)
+	}
+}
+
+func TestTaskTags(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	space := srv.AddSpace(team, "Engineering")
+	list := srv.AddList(space, "", "Backlog")
+	task := srv.AddTask(list, clickuptest.Task{Name: "Fix login"})
+	ctx := context.Background()
+
+	if _, err := client.Spaces.CreateTag(ctx, space, clickup.Tag{Name: "urgent", ForegroundColor: "#ffffff", BackgroundColor: "#ff0000"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Tasks.AddTag(ctx, task, "urgent", ""); err != nil {
+		t.Fatal(err)
+	}
+	got, _, err := client.Tasks.Get(ctx, task, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Tags) != 1 || got.Tags[0].Name != "urgent" || got.Tags[0].BackgroundColor != "#ff0000" {
+		t.Errorf("tags of task = %+v", got.Tags)
+	}
+
+	if _, err := client.Tasks.RemoveTag(ctx, task, "urgent", ""); err != nil {
+		t.Fatal(err)
+	}
+	got, _, err = client.Tasks.Get(ctx, task, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Tags) != 0 {
+		t.Errorf("tags of task after removal = %+v", got.Tags)
+	}
+}
@@ -10,11 +10,13 @@ import (
 
 var timestampType = reflect.TypeOf(Timestamp{})
 
+// Stringify attempts to create a reasonable string representation of types
+// in the ClickUp library. Credentials found in the output are redacted.
 func Stringify(message interface{}) string {
 	var buf bytes.Buffer
 	v := reflect.ValueOf(message)
 	stringifyValue(&buf, v)
-	return buf.String()
+	return Redact(buf.String())
 }
 
 // stringifyValue was heavily inspired by the goprotobuf library.
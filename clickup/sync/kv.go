@@ -0,0 +1,360 @@
+package sync
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	stdsync "sync"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// KV is an ordered key-value store. It is implemented by LogKV and can wrap
+// any embedded database to hold a mirror through KVStore.
+type KV interface {
+	// Get returns the value of key, or ErrNotFound.
+	Get(key string) ([]byte, error)
+	Put(key string, value []byte) error
+	Delete(key string) error
+	// Scan calls fn for every key starting with prefix, in key order, until
+	// fn returns an error, which Scan returns.
+	Scan(prefix string, fn func(key string, value []byte) error) error
+	Flush() error
+	Close() error
+}
+
+// KVStore is a Store on top of a KV. Tasks are stored under "task/<id>",
+// marks under "mark/<list id>" and changes under "change/<seq>", with the
+// sequence number zero padded to 20 digits.
+type KVStore struct {
+	kv  KV
+	seq uint64
+}
+
+// NewKVStore returns a Store using kv, continuing its change feed.
+func NewKVStore(kv KV) (*KVStore, error) {
+	s := &KVStore{kv: kv}
+	err := kv.Scan("change/", func(key string, _ []byte) error {
+		seq, err := strconv.ParseUint(strings.TrimPrefix(key, "change/"), 10, 64)
+		if err != nil {
+			return fmt.Errorf("sync: bad change key %q", key)
+		}
+		s.seq = seq
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *KVStore) Task(id string) (*clickup.Task, error) {
+	b, err := s.kv.Get("task/" + id)
+	if err != nil {
+		return nil, err
+	}
+	t := new(clickup.Task)
+	if err := json.Unmarshal(b, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *KVStore) Tasks(listID string) ([]clickup.Task, error) {
+	var tasks []clickup.Task
+	err := s.kv.Scan("task/", func(_ string, value []byte) error {
+		var t clickup.Task
+		if err := json.Unmarshal(value, &t); err != nil {
+			return err
+		}
+		if listID == "" || t.List.ID == listID {
+			tasks = append(tasks, t)
+		}
+		return nil
+	})
+	return tasks, err
+}
+
+func (s *KVStore) PutTask(t clickup.Task) error {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	return s.kv.Put("task/"+t.ID, b)
+}
+
+func (s *KVStore) DeleteTask(id string) error {
+	return s.kv.Delete("task/" + id)
+}
+
+func (s *KVStore) Marks() (map[string]time.Time, error) {
+	marks := make(map[string]time.Time)
+	err := s.kv.Scan("mark/", func(key string, value []byte) error {
+		var t time.Time
+		if err := t.UnmarshalText(value); err != nil {
+			return err
+		}
+		marks[strings.TrimPrefix(key, "mark/")] = t
+		return nil
+	})
+	return marks, err
+}
+
+func (s *KVStore) SetMark(listID string, t time.Time) error {
+	b, err := t.MarshalText()
+	if err != nil {
+		return err
+	}
+	return s.kv.Put("mark/"+listID, b)
+}
+
+func (s *KVStore) DeleteMark(listID string) error {
+	return s.kv.Delete("mark/" + listID)
+}
+
+func (s *KVStore) AppendChange(c *Change) error {
+	c.Seq = s.seq + 1
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := s.kv.Put(changeKey(c.Seq), b); err != nil {
+		return err
+	}
+	s.seq = c.Seq
+	return nil
+}
+
+func (s *KVStore) Changes(since uint64, limit int) ([]Change, error) {
+	var changes []Change
+	stop := errors.New("stop")
+	err := s.kv.Scan("change/", func(key string, value []byte) error {
+		if key <= changeKey(since) {
+			return nil
+		}
+		var c Change
+		if err := json.Unmarshal(value, &c); err != nil {
+			return err
+		}
+		changes = append(changes, c)
+		if limit > 0 && len(changes) == limit {
+			return stop
+		}
+		return nil
+	})
+	if err == stop {
+		err = nil
+	}
+	return changes, err
+}
+
+func (s *KVStore) Flush() error {
+	return s.kv.Flush()
+}
+
+func (s *KVStore) Close() error {
+	return s.kv.Close()
+}
+
+func changeKey(seq uint64) string {
+	return fmt.Sprintf("change/%020d", seq)
+}
+
+// LogKV is an embedded KV keeping its data in memory and appending every
+// write to a log file, one JSON record per line. Opening the file replays
+// the log, ignoring a last record cut short by a crash. The log is rewritten
+// without the overwritten and deleted records once they outnumber the live
+// ones.
+type LogKV struct {
+	path string
+
+	mu   stdsync.Mutex
+	f    *os.File
+	w    *bufio.Writer
+	data map[string][]byte
+	dead int
+}
+
+type logRecord struct {
+	Key     string `json:"k"`
+	Value   []byte `json:"v,omitempty"`
+	Deleted bool   `json:"d,omitempty"`
+}
+
+// minCompaction is the number of dead records below which the log is never
+// rewritten.
+const minCompaction = 1000
+
+// OpenLogKV opens the log at path, creating it if needed.
+func OpenLogKV(path string) (*LogKV, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	kv := &LogKV{path: path, f: f, data: make(map[string][]byte)}
+
+	// Replay the complete records, then truncate whatever follows them.
+	var good int64
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		var rec logRecord
+		if json.Unmarshal(line, &rec) != nil {
+			break
+		}
+		kv.apply(rec)
+		good += int64(len(line))
+	}
+	if err := f.Truncate(good); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err := f.Seek(good, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	kv.w = bufio.NewWriter(f)
+	return kv, nil
+}
+
+func (kv *LogKV) apply(rec logRecord) {
+	if _, ok := kv.data[rec.Key]; ok {
+		kv.dead++
+	}
+	if rec.Deleted {
+		delete(kv.data, rec.Key)
+		kv.dead++
+		return
+	}
+	kv.data[rec.Key] = rec.Value
+}
+
+func (kv *LogKV) Get(key string) ([]byte, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	v, ok := kv.data[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return append([]byte(nil), v...), nil
+}
+
+func (kv *LogKV) Put(key string, value []byte) error {
+	return kv.write(logRecord{Key: key, Value: append([]byte(nil), value...)})
+}
+
+func (kv *LogKV) Delete(key string) error {
+	kv.mu.Lock()
+	_, ok := kv.data[key]
+	kv.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return kv.write(logRecord{Key: key, Deleted: true})
+}
+
+func (kv *LogKV) write(rec logRecord) error {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if _, err := kv.w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	kv.apply(rec)
+	return nil
+}
+
+func (kv *LogKV) Scan(prefix string, fn func(key string, value []byte) error) error {
+	kv.mu.Lock()
+	var keys []string
+	for k := range kv.data {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	values := make([][]byte, len(keys))
+	for i, k := range keys {
+		values[i] = kv.data[k]
+	}
+	kv.mu.Unlock()
+
+	for i, k := range keys {
+		if err := fn(k, values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes the buffered records to the log and syncs it to disk,
+// compacting the log first if it holds more dead records than live ones.
+func (kv *LogKV) Flush() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.dead >= minCompaction && kv.dead > len(kv.data) {
+		return kv.compact()
+	}
+	if err := kv.w.Flush(); err != nil {
+		return err
+	}
+	return kv.f.Sync()
+}
+
+// compact rewrites the log with only the live records.
+func (kv *LogKV) compact() error {
+	keys := make([]string, 0, len(kv.data))
+	for k := range kv.data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var buf bytes.Buffer
+	for _, k := range keys {
+		b, err := json.Marshal(logRecord{Key: k, Value: kv.data[k]})
+		if err != nil {
+			return err
+		}
+		buf.Write(b)
+		buf.WriteByte('\n')
+	}
+	if err := writeFile(kv.path, buf.Bytes()); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(kv.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	kv.f.Close()
+	kv.f, kv.w, kv.dead = f, bufio.NewWriter(f), 0
+	return nil
+}
+
+// Close flushes and closes the log.
+func (kv *LogKV) Close() error {
+	err := kv.Flush()
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if cerr := kv.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
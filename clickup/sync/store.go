@@ -0,0 +1,215 @@
+package sync
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	stdsync "sync"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// ErrNotFound is returned by a Store or KV for a key it does not hold.
+var ErrNotFound = errors.New("not found")
+
+// Store persists the mirror of a workspace: the tasks, the high-water mark
+// of every list and the change feed. Writes may be buffered until Flush.
+// A Store is used by one Mirror at a time, which serializes its calls.
+type Store interface {
+	// Task returns the task with the given ID, or ErrNotFound.
+	Task(id string) (*clickup.Task, error)
+	// Tasks returns the tasks of a list, or of every list if listID is
+	// empty.
+	Tasks(listID string) ([]clickup.Task, error)
+	PutTask(t clickup.Task) error
+	DeleteTask(id string) error
+
+	// Marks returns the high-water mark of every list: the latest
+	// date_updated of the tasks of the list fetched so far.
+	Marks() (map[string]time.Time, error)
+	SetMark(listID string, t time.Time) error
+	DeleteMark(listID string) error
+
+	// AppendChange stores c with the next sequence number, which it sets.
+	AppendChange(c *Change) error
+	// Changes returns at most limit changes with a sequence number greater
+	// than since, in order. A limit <= 0 means no limit.
+	Changes(since uint64, limit int) ([]Change, error)
+
+	Flush() error
+	Close() error
+}
+
+// FileStore is a Store keeping everything in memory and in a single JSON
+// file, rewritten on Flush. It suits mirrors of up to a few ten thousand
+// tasks; KVStore scales further.
+type FileStore struct {
+	path string
+
+	mu    stdsync.Mutex
+	data  fileData
+	dirty bool
+}
+
+type fileData struct {
+	Tasks   map[string]clickup.Task `json:"tasks"`
+	Marks   map[string]time.Time    `json:"marks"`
+	Changes []Change                `json:"changes"`
+	Seq     uint64                  `json:"seq"`
+}
+
+// OpenFileStore opens the store in the file at path, which is created by the
+// first Flush if it does not exist.
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path}
+	b, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(b, &s.data); err != nil {
+			return nil, err
+		}
+	}
+	if s.data.Tasks == nil {
+		s.data.Tasks = make(map[string]clickup.Task)
+	}
+	if s.data.Marks == nil {
+		s.data.Marks = make(map[string]time.Time)
+	}
+	return s, nil
+}
+
+func (s *FileStore) Task(id string) (*clickup.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.data.Tasks[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &t, nil
+}
+
+func (s *FileStore) Tasks(listID string) ([]clickup.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var tasks []clickup.Task
+	for _, t := range s.data.Tasks {
+		if listID == "" || t.List.ID == listID {
+			tasks = append(tasks, t)
+		}
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].ID < tasks[j].ID })
+	return tasks, nil
+}
+
+func (s *FileStore) PutTask(t clickup.Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Tasks[t.ID] = t
+	s.dirty = true
+	return nil
+}
+
+func (s *FileStore) DeleteTask(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.data.Tasks, id)
+	s.dirty = true
+	return nil
+}
+
+func (s *FileStore) Marks() (map[string]time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	marks := make(map[string]time.Time, len(s.data.Marks))
+	for k, v := range s.data.Marks {
+		marks[k] = v
+	}
+	return marks, nil
+}
+
+func (s *FileStore) SetMark(listID string, t time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Marks[listID] = t
+	s.dirty = true
+	return nil
+}
+
+func (s *FileStore) DeleteMark(listID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.data.Marks, listID)
+	s.dirty = true
+	return nil
+}
+
+func (s *FileStore) AppendChange(c *Change) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Seq++
+	c.Seq = s.data.Seq
+	s.data.Changes = append(s.data.Changes, *c)
+	s.dirty = true
+	return nil
+}
+
+func (s *FileStore) Changes(since uint64, limit int) ([]Change, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := sort.Search(len(s.data.Changes), func(i int) bool { return s.data.Changes[i].Seq > since })
+	changes := s.data.Changes[i:]
+	if limit > 0 && len(changes) > limit {
+		changes = changes[:limit]
+	}
+	return append([]Change(nil), changes...), nil
+}
+
+// Flush writes the store to its file if it changed, replacing the file
+// atomically.
+func (s *FileStore) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.dirty {
+		return nil
+	}
+	b, err := json.Marshal(s.data)
+	if err != nil {
+		return err
+	}
+	if err := writeFile(s.path, b); err != nil {
+		return err
+	}
+	s.dirty = false
+	return nil
+}
+
+// Close flushes the store.
+func (s *FileStore) Close() error {
+	return s.Flush()
+}
+
+// writeFile writes b to a temporary file renamed to path, so that path is
+// never left half written.
+func writeFile(path string, b []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,405 @@
+// Package sync maintains a local mirror of the tasks of a ClickUp workspace.
+//
+// A Mirror polls the team task endpoint for the tasks updated since the
+// high-water mark of each list, applies webhook events as they arrive, and
+// records every change it makes in a feed that consumers read incrementally
+// instead of fetching the workspace again:
+//
+//	store, err := sync.OpenFileStore("mirror.json")
+//	...
+//	m := sync.NewMirror(client, teamID, store)
+//	http.Handle("/clickup/webhook", m.WebhookHandler(secret))
+//	for range time.Tick(15 * time.Minute) {
+//		if _, err := m.Sync(ctx); err != nil {
+//			log.Print(err)
+//		}
+//	}
+//
+// Polling with date_updated_gt does not report deleted tasks. They are
+// removed on taskDeleted events and by Reconcile, which lists every task of
+// the mirrored lists and should run from time to time.
+package sync
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sort"
+	"strconv"
+	stdsync "sync"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/hierarchy"
+)
+
+// ChangeKind is what happened to a task.
+type ChangeKind string
+
+const (
+	Created ChangeKind = "created"
+	Updated ChangeKind = "updated"
+	// Deleted is recorded for tasks deleted, archived or moved out of the
+	// mirrored lists.
+	Deleted ChangeKind = "deleted"
+)
+
+// Source is what a change was learned from.
+type Source string
+
+const (
+	SourceSync      Source = "sync"
+	SourceReconcile Source = "reconcile"
+	SourceWebhook   Source = "webhook"
+)
+
+// Change is an entry of the change feed of a mirror. The task itself is read
+// from the Store.
+type Change struct {
+	Seq    uint64     `json:"seq"`
+	Kind   ChangeKind `json:"kind"`
+	TaskID string     `json:"task_id"`
+	ListID string     `json:"list_id"`
+	// Time is when the change was recorded.
+	Time   time.Time `json:"time"`
+	Source Source    `json:"source"`
+}
+
+// listsPerRequest is the number of list IDs sent in one team task query.
+const listsPerRequest = 50
+
+// Mirror keeps a Store in sync with the tasks of a workspace. Its methods
+// may be called concurrently.
+type Mirror struct {
+	// ListIDs restricts the mirror to these lists. By default every list of
+	// the workspace is mirrored, as found by a hierarchy.Walker on every
+	// Sync.
+	ListIDs []string
+
+	// Overlap is subtracted from the high-water marks when polling, so that
+	// tasks updated while the previous poll ran are not missed. Tasks fetched
+	// again without having changed are not recorded as changes. It defaults
+	// to one minute.
+	Overlap time.Duration
+
+	client      *clickup.Client
+	workspaceID string
+	store       Store
+
+	// mu serializes the writes to the store.
+	mu   stdsync.Mutex
+	subs map[chan Change]bool
+	now  func() time.Time
+}
+
+// NewMirror returns a Mirror of the workspace in store.
+func NewMirror(c *clickup.Client, workspaceID string, store Store) *Mirror {
+	return &Mirror{
+		client:      c,
+		workspaceID: workspaceID,
+		store:       store,
+		subs:        make(map[chan Change]bool),
+		now:         time.Now,
+	}
+}
+
+// Store returns the store of the mirror.
+func (m *Mirror) Store() Store {
+	return m.store
+}
+
+// Sync fetches the tasks updated since the last Sync in every mirrored list,
+// and every task of the lists not synced before, and returns the changes
+// made. The tasks of lists that no longer exist are deleted.
+func (m *Mirror) Sync(ctx context.Context) ([]Change, error) {
+	return m.sync(ctx, SourceSync)
+}
+
+// Reconcile fetches every task of the mirrored lists, deleting the stored
+// tasks that were not returned, and returns the changes made.
+func (m *Mirror) Reconcile(ctx context.Context) ([]Change, error) {
+	return m.sync(ctx, SourceReconcile)
+}
+
+func (m *Mirror) sync(ctx context.Context, source Source) (changes []Change, err error) {
+	defer func() {
+		if ferr := m.flush(); err == nil {
+			err = ferr
+		}
+	}()
+
+	lists, err := m.lists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	marks, err := m.store.Marks()
+	if err != nil {
+		return nil, err
+	}
+
+	// Drop the lists that are gone.
+	mirrored := make(map[string]bool, len(lists))
+	for _, id := range lists {
+		mirrored[id] = true
+	}
+	for id := range marks {
+		if mirrored[id] {
+			continue
+		}
+		cs, err := m.dropList(id, source)
+		changes = append(changes, cs...)
+		if err != nil {
+			return changes, err
+		}
+	}
+
+	// Query the lists with the same mark together. Reconcile queries all of
+	// them from the start.
+	groups := make(map[time.Time][]string)
+	for _, id := range lists {
+		var since time.Time
+		if source == SourceSync {
+			since = marks[id]
+		}
+		groups[since] = append(groups[since], id)
+	}
+	var sinces []time.Time
+	for since := range groups {
+		sinces = append(sinces, since)
+	}
+	sort.Slice(sinces, func(i, j int) bool { return sinces[i].Before(sinces[j]) })
+
+	for _, since := range sinces {
+		ids := groups[since]
+		for len(ids) > 0 {
+			n := len(ids)
+			if n > listsPerRequest {
+				n = listsPerRequest
+			}
+			cs, err := m.fetch(ctx, ids[:n], since, marks, source)
+			changes = append(changes, cs...)
+			if err != nil {
+				return changes, err
+			}
+			ids = ids[n:]
+		}
+	}
+	return changes, nil
+}
+
+// lists returns the IDs of the mirrored lists.
+func (m *Mirror) lists(ctx context.Context) ([]string, error) {
+	if len(m.ListIDs) > 0 {
+		return m.ListIDs, nil
+	}
+	tree, err := hierarchy.NewWalker(m.client, m.workspaceID).Walk(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, l := range tree.Lists() {
+		ids = append(ids, l.ID)
+	}
+	return ids, nil
+}
+
+// fetch applies the tasks of lists updated after since, or all of them if
+// since is zero, and advances the marks of the lists.
+func (m *Mirror) fetch(ctx context.Context, lists []string, since time.Time, marks map[string]time.Time, source Source) ([]Change, error) {
+	q := url.Values{}
+	q.Set("include_closed", "true")
+	q.Set("subtasks", "true")
+	for _, id := range lists {
+		q.Add("list_ids[]", id)
+	}
+	full := since.IsZero()
+	if !full {
+		q.Set("date_updated_gt", strconv.FormatInt(since.Add(-m.overlap()).UnixNano()/int64(time.Millisecond), 10))
+	}
+
+	var changes []Change
+	seen := make(map[string]bool)
+	latest := make(map[string]time.Time)
+	it := m.client.Tasks.ForTeamIter(ctx, m.workspaceID, "?"+q.Encode())
+	defer it.Close()
+	for it.Next() {
+		t := it.Task()
+		seen[t.ID] = true
+		if u := millis(t.DateUpdated); u.After(latest[t.List.ID]) {
+			latest[t.List.ID] = u
+		}
+		c, err := m.put(t, source)
+		if err != nil {
+			return changes, err
+		}
+		if c != nil {
+			changes = append(changes, *c)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return changes, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range lists {
+		if full {
+			// Whatever was not listed is gone from the list.
+			stored, err := m.store.Tasks(id)
+			if err != nil {
+				return changes, err
+			}
+			for _, t := range stored {
+				if seen[t.ID] {
+					continue
+				}
+				c, err := m.deleteLocked(t.ID, id, source)
+				if err != nil {
+					return changes, err
+				}
+				changes = append(changes, *c)
+			}
+		}
+		mark := marks[id]
+		if latest[id].After(mark) {
+			mark = latest[id]
+		}
+		if mark.IsZero() {
+			// Remember that the list was synced even if it is empty.
+			mark = time.Unix(0, 0).UTC()
+		}
+		if err := m.store.SetMark(id, mark); err != nil {
+			return changes, err
+		}
+	}
+	return changes, nil
+}
+
+// put stores t, returning the change made, or nil if the stored task is the
+// same or more recent.
+func (m *Mirror) put(t clickup.Task, source Source) (*Change, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	kind := Created
+	old, err := m.store.Task(t.ID)
+	switch {
+	case err == nil:
+		if old.List.ID == t.List.ID && !millis(t.DateUpdated).After(millis(old.DateUpdated)) {
+			return nil, nil
+		}
+		kind = Updated
+	case !errors.Is(err, ErrNotFound):
+		return nil, err
+	}
+	if err := m.store.PutTask(t); err != nil {
+		return nil, err
+	}
+	return m.recordLocked(Change{Kind: kind, TaskID: t.ID, ListID: t.List.ID, Source: source})
+}
+
+// delete removes the task from the store, returning the change made, or nil
+// if the task was not stored.
+func (m *Mirror) delete(id string, source Source) (*Change, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	old, err := m.store.Task(id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.deleteLocked(id, old.List.ID, source)
+}
+
+func (m *Mirror) deleteLocked(id, listID string, source Source) (*Change, error) {
+	if err := m.store.DeleteTask(id); err != nil {
+		return nil, err
+	}
+	return m.recordLocked(Change{Kind: Deleted, TaskID: id, ListID: listID, Source: source})
+}
+
+// dropList deletes the tasks and the mark of a list.
+func (m *Mirror) dropList(listID string, source Source) ([]Change, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	tasks, err := m.store.Tasks(listID)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	for _, t := range tasks {
+		c, err := m.deleteLocked(t.ID, listID, source)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, *c)
+	}
+	return changes, m.store.DeleteMark(listID)
+}
+
+// recordLocked appends c to the change feed and sends it to the subscribers.
+func (m *Mirror) recordLocked(c Change) (*Change, error) {
+	c.Time = m.now()
+	if err := m.store.AppendChange(&c); err != nil {
+		return nil, err
+	}
+	for ch := range m.subs {
+		select {
+		case ch <- c:
+		default:
+		}
+	}
+	return &c, nil
+}
+
+func (m *Mirror) flush() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.store.Flush()
+}
+
+// Changes returns at most limit changes recorded after the change numbered
+// since, in order. A consumer keeps the Seq of the last change it processed
+// and passes it to the next call. A limit <= 0 means no limit.
+func (m *Mirror) Changes(since uint64, limit int) ([]Change, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.store.Changes(since, limit)
+}
+
+// Subscribe returns a channel receiving the changes as they are recorded,
+// and a function to unsubscribe. Changes are dropped while the channel
+// buffer is full; a subscriber falling behind reads them back with Changes.
+func (m *Mirror) Subscribe(buffer int) (<-chan Change, func()) {
+	ch := make(chan Change, buffer)
+	m.mu.Lock()
+	m.subs[ch] = true
+	m.mu.Unlock()
+	var once stdsync.Once
+	return ch, func() {
+		once.Do(func() {
+			m.mu.Lock()
+			delete(m.subs, ch)
+			m.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+func (m *Mirror) overlap() time.Duration {
+	if m.Overlap > 0 {
+		return m.Overlap
+	}
+	return time.Minute
+}
+
+// millis parses a time in milliseconds since the epoch, as ClickUp returns
+// them, giving the zero time for an empty or invalid value.
+func millis(s string) time.Time {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(0, v*int64(time.Millisecond))
+}
@@ -0,0 +1,231 @@
+package sync_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+	"github.com/catdevman/go-clickup/clickup/sync"
+)
+
+func do(t *testing.T, c *clickup.Client, method, path string, body, v interface{}) {
+	t.Helper()
+	req, err := c.NewRequest(method, path, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Do(context.Background(), req, v); err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+}
+
+// newServer returns a fake whose clock advances a second on every reading,
+// so that every update of a task gets a new date_updated.
+func newServer() *clickuptest.Server {
+	srv := clickuptest.NewServer()
+	now := time.Unix(1700000000, 0)
+	srv.Now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	return srv
+}
+
+func kinds(changes []sync.Change) map[sync.ChangeKind]int {
+	n := make(map[sync.ChangeKind]int)
+	for _, c := range changes {
+		n[c.Kind]++
+	}
+	return n
+}
+
+func TestMirror(t *testing.T) {
+	stores := map[string]func(dir string) (sync.Store, error){
+		"file": func(dir string) (sync.Store, error) {
+			return sync.OpenFileStore(filepath.Join(dir, "mirror.json"))
+		},
+		"kv": func(dir string) (sync.Store, error) {
+			kv, err := sync.OpenLogKV(filepath.Join(dir, "mirror.log"))
+			if err != nil {
+				return nil, err
+			}
+			return sync.NewKVStore(kv)
+		},
+	}
+	for name, open := range stores {
+		t.Run(name, func(t *testing.T) {
+			testMirror(t, open)
+		})
+	}
+}
+
+func testMirror(t *testing.T, open func(dir string) (sync.Store, error)) {
+	srv := newServer()
+	defer srv.Close()
+	team := srv.AddWorkspace("Acme")
+	space := srv.AddSpace(team, "Engineering")
+	backlog := srv.AddList(space, "", "Backlog")
+	sprint := srv.AddList(space, "", "Sprint")
+	design := srv.AddTask(backlog, clickuptest.Task{Name: "Design"})
+	build := srv.AddTask(backlog, clickuptest.Task{Name: "Build"})
+	srv.AddTask(sprint, clickuptest.Task{Name: "Ship"})
+	client := srv.Client()
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := sync.NewMirror(client, team, store)
+
+	changes, err := m.Sync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := kinds(changes); n[sync.Created] != 3 || len(changes) != 3 {
+		t.Fatalf("first sync changes = %v", changes)
+	}
+
+	do(t, client, "PUT", "task/"+design, map[string]interface{}{"name": "Design v2"}, nil)
+	changes, err = m.Sync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Kind != sync.Updated || changes[0].TaskID != design {
+		t.Errorf("sync after update = %v", changes)
+	}
+	if task, err := store.Task(design); err != nil || task.Name != "Design v2" {
+		t.Errorf("stored task = %v, %v", task, err)
+	}
+
+	// Deletions are not seen by polling, only by reconciling.
+	do(t, client, "DELETE", "task/"+build, nil, nil)
+	if changes, err = m.Sync(ctx); err != nil || len(changes) != 0 {
+		t.Errorf("sync after delete = %v, %v", changes, err)
+	}
+	changes, err = m.Reconcile(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Kind != sync.Deleted || changes[0].TaskID != build {
+		t.Errorf("reconcile after delete = %v", changes)
+	}
+
+	do(t, client, "DELETE", "list/"+sprint, nil, nil)
+	changes, err = m.Sync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Kind != sync.Deleted || changes[0].ListID != sprint {
+		t.Errorf("sync after list delete = %v", changes)
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+	store, err = open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	feed, err := store.Changes(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(feed) != 6 {
+		t.Fatalf("reopened feed has %d changes, want 6", len(feed))
+	}
+	for i, c := range feed {
+		if c.Seq != uint64(i+1) {
+			t.Errorf("change %d has seq %d", i, c.Seq)
+		}
+	}
+	if tail, _ := store.Changes(4, 1); len(tail) != 1 || tail[0].Seq != 5 {
+		t.Errorf("Changes(4, 1) = %v", tail)
+	}
+	tasks, err := store.Tasks("")
+	if err != nil || len(tasks) != 1 || tasks[0].ID != design {
+		t.Errorf("stored tasks = %v, %v", tasks, err)
+	}
+}
+
+func TestMirrorWebhook(t *testing.T) {
+	srv := newServer()
+	defer srv.Close()
+	team := srv.AddWorkspace("Acme")
+	space := srv.AddSpace(team, "Engineering")
+	list := srv.AddList(space, "", "Backlog")
+	client := srv.Client()
+
+	dir, err := ioutil.TempDir("", "sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := sync.OpenFileStore(filepath.Join(dir, "mirror.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := sync.NewMirror(client, team, store)
+	feed, unsubscribe := m.Subscribe(10)
+	defer unsubscribe()
+
+	var handler http.Handler
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+	}))
+	defer hook.Close()
+	var created struct {
+		Webhook clickup.Webhook `json:"webhook"`
+	}
+	do(t, client, "POST", "team/"+team+"/webhook", map[string]interface{}{
+		"endpoint": hook.URL,
+		"events":   []string{"*"},
+	}, &created)
+	handler = m.WebhookHandler(created.Webhook.Secret)
+
+	var task clickup.Task
+	do(t, client, "POST", "list/"+list+"/task", map[string]interface{}{"name": "Write tests"}, &task)
+	do(t, client, "PUT", "task/"+task.ID, map[string]interface{}{"status": "in progress"}, nil)
+	if got, err := store.Task(task.ID); err != nil || got.Status.Status != "in progress" {
+		t.Errorf("mirrored task = %v, %v", got, err)
+	}
+	do(t, client, "DELETE", "task/"+task.ID, nil, nil)
+
+	var got []sync.ChangeKind
+	for len(got) < 3 {
+		select {
+		case c := <-feed:
+			got = append(got, c.Kind)
+		case <-time.After(time.Second):
+			t.Fatalf("received changes %v, want 3", got)
+		}
+	}
+	if got[0] != sync.Created || got[len(got)-1] != sync.Deleted {
+		t.Errorf("changes = %v", got)
+	}
+	if _, err := store.Task(task.ID); err != sync.ErrNotFound {
+		t.Errorf("deleted task still mirrored: %v", err)
+	}
+
+	// Deliveries that are not signed with the secret are rejected.
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", nil)
+	req.Header.Set("X-Signature", clickuptest.Sign("other", nil))
+	m.WebhookHandler(created.Webhook.Secret).ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("forged delivery status = %d, want 401", rec.Code)
+	}
+}
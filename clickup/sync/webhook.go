@@ -0,0 +1,119 @@
+package sync
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+// maxWebhookBody is the largest webhook payload accepted.
+const maxWebhookBody = 1 << 20
+
+// event is the part of a webhook payload the mirror uses.
+type event struct {
+	Event  string `json:"event"`
+	TaskID string `json:"task_id"`
+	ListID string `json:"list_id"`
+}
+
+// WebhookHandler returns a handler for the deliveries of a ClickUp webhook
+// registered for the workspace. It checks the X-Signature header against
+// secret, the secret returned when the webhook was created, unless secret is
+// empty.
+//
+// Task events update the task from the API, or delete it for taskDeleted,
+// and listDeleted deletes the tasks of the list. Other events are ignored.
+// The handler responds with an error status when the mirror could not be
+// updated, so that ClickUp delivers the event again.
+func (m *Mirror) WebhookHandler(secret string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if secret != "" && !validSignature(secret, body, r.Header.Get("X-Signature")) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		var e event
+		if err := json.Unmarshal(body, &e); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = m.handleEvent(r, e)
+		if ferr := m.flush(); err == nil {
+			err = ferr
+		}
+		if err != nil {
+			http.Error(w, clickup.Redact(err.Error()), http.StatusInternalServerError)
+		}
+	})
+}
+
+func (m *Mirror) handleEvent(r *http.Request, e event) error {
+	switch {
+	case e.Event == "taskDeleted":
+		_, err := m.delete(e.TaskID, SourceWebhook)
+		return err
+
+	case e.Event == "listDeleted":
+		if !m.mirrors(e.ListID) {
+			return nil
+		}
+		_, err := m.dropList(e.ListID, SourceWebhook)
+		return err
+
+	case strings.HasPrefix(e.Event, "task") && e.TaskID != "":
+		if strings.HasPrefix(e.Event, "taskComment") {
+			return nil
+		}
+		t, _, err := m.client.Tasks.Get(r.Context(), e.TaskID, "")
+		var notFound *clickup.ErrorResponse
+		if errors.As(err, &notFound) && notFound.Response != nil && notFound.Response.StatusCode == http.StatusNotFound {
+			_, err = m.delete(e.TaskID, SourceWebhook)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if !m.mirrors(t.List.ID) {
+			// Moved out of the mirrored lists.
+			_, err = m.delete(t.ID, SourceWebhook)
+			return err
+		}
+		_, err = m.put(*t, SourceWebhook)
+		return err
+	}
+	return nil
+}
+
+// mirrors reports whether the list is mirrored.
+func (m *Mirror) mirrors(listID string) bool {
+	if len(m.ListIDs) == 0 {
+		return true
+	}
+	for _, id := range m.ListIDs {
+		if id == listID {
+			return true
+		}
+	}
+	return false
+}
+
+func validSignature(secret string, body []byte, signature string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	want := mac.Sum(nil)
+	got, err := hex.DecodeString(signature)
+	return err == nil && hmac.Equal(got, want)
+}
@@ -2,13 +2,18 @@ package clickup
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
+	"time"
 )
 
 type TasksService service
 
 type TasksWrapper struct {
-	Tasks []Task `json:"tasks"`
+	Tasks    []Task `json:"tasks"`
+	LastPage bool   `json:"last_page"`
 }
 
 type Task struct {
@@ -33,20 +38,15 @@ type Task struct {
 		Color          string `json:"color"`
 		ProfilePicture string `json:"profilePicture"`
 	} `json:"creator"`
-	Assignees  []interface{} `json:"assignees"`
-	Checklists []interface{} `json:"checklists"`
-	Tags       []struct {
-		Creator         int64  `json:"creator"`
-		Name            string `json:"name"`
-		BackgroundColor string `json:"tag_bg"`
-		ForegroundColor string `json:"tag_fg"`
-	} `json:"tags"`
-	Parent       interface{} `json:"parent"`
-	Priority     interface{} `json:"priority"`
-	DueDate      string      `json:"due_date"`
-	StartDate    string      `json:"start_date"`
-	TimeEstimate interface{} `json:"time_estimate"`
-	TimeSpent    interface{} `json:"time_spent"`
+	Assignees    []interface{} `json:"assignees"`
+	Checklists   []interface{} `json:"checklists"`
+	Tags         []Tag         `json:"tags"`
+	Parent       interface{}   `json:"parent"`
+	Priority     interface{}   `json:"priority"`
+	DueDate      string        `json:"due_date"`
+	StartDate    string        `json:"start_date"`
+	TimeEstimate interface{}   `json:"time_estimate"`
+	TimeSpent    interface{}   `json:"time_spent"`
 	CustomFields []struct {
 		ID             string      `json:"id"`
 		Name           string      `json:"name"`
@@ -66,7 +66,176 @@ type Task struct {
 	Space struct {
 		ID string `json:"id"`
 	} `json:"space"`
-	Url string `json:"url"`
+	Url          string           `json:"url"`
+	Dependencies []TaskDependency `json:"dependencies"`
+	LinkedTasks  []TaskLink       `json:"linked_tasks"`
+}
+
+type TaskWrapper struct {
+	Task Task `json:"task"`
+}
+
+type TaskDependency struct {
+	TaskID      string `json:"task_id"`
+	DependsOn   string `json:"depends_on"`
+	Type        int    `json:"type"`
+	DateCreated string `json:"date_created"`
+	UserID      string `json:"userid"`
+}
+
+type TaskLink struct {
+	TaskID      string `json:"task_id"`
+	LinkID      string `json:"link_id"`
+	DateCreated string `json:"date_created"`
+	UserID      string `json:"userid"`
+}
+
+type TrackedTimeWrapper struct {
+	TrackedTime []TrackedTime `json:"data"`
+}
+
+// TrackedTime is the time a single user tracked on a task with the legacy
+// time tracking endpoints.
+type TrackedTime struct {
+	User struct {
+		ID             int64  `json:"id"`
+		Username       string `json:"username"`
+		Email          string `json:"email"`
+		Color          string `json:"color"`
+		Initials       string `json:"initials"`
+		ProfilePicture string `json:"profilePicture"`
+	} `json:"user"`
+	Time      time.Duration  `json:"time"`
+	Intervals []TimeInterval `json:"intervals"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *TrackedTime) UnmarshalJSON(data []byte) error {
+	type aliasTrackedTime TrackedTime // avoid infinite recursion by using type alias.
+	aux := struct {
+		*aliasTrackedTime
+		Time json.RawMessage `json:"time"`
+	}{aliasTrackedTime: (*aliasTrackedTime)(t)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	d, err := parseMilliseconds(aux.Time)
+	if err != nil {
+		return err
+	}
+	t.Time = d
+	return nil
+}
+
+type TimeInterval struct {
+	ID        string        `json:"id"`
+	Start     Timestamp     `json:"start"`
+	End       Timestamp     `json:"end"`
+	Time      time.Duration `json:"time"`
+	Source    string        `json:"source"`
+	DateAdded Timestamp     `json:"date_added"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (i *TimeInterval) UnmarshalJSON(data []byte) error {
+	type aliasTimeInterval TimeInterval // avoid infinite recursion by using type alias.
+	aux := struct {
+		*aliasTimeInterval
+		Time json.RawMessage `json:"time"`
+	}{aliasTimeInterval: (*aliasTimeInterval)(i)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	d, err := parseMilliseconds(aux.Time)
+	if err != nil {
+		return err
+	}
+	i.Time = d
+	return nil
+}
+
+var errNilInterval = errors.New("interval must be non-nil")
+
+// TrackTimeRequest describes an interval for TrackTime and EditTrackedTime.
+// Either End or Duration must be set; the other one is derived from it.
+type TrackTimeRequest struct {
+	Start    time.Time
+	End      time.Time
+	Duration time.Duration
+}
+
+// MarshalJSON implements the json.Marshaler interface, sending times and
+// durations as milliseconds like ClickUp expects.
+func (r *TrackTimeRequest) MarshalJSON() ([]byte, error) {
+	end, d := r.interval()
+	return json.Marshal(struct {
+		Start int64 `json:"start"`
+		End   int64 `json:"end"`
+		Time  int64 `json:"time"`
+	}{r.Start.UnixNano() / int64(time.Millisecond), end.UnixNano() / int64(time.Millisecond), d.Milliseconds()})
+}
+
+func (r *TrackTimeRequest) interval() (time.Time, time.Duration) {
+	if r.End.IsZero() {
+		return r.Start.Add(r.Duration), r.Duration
+	}
+	return r.End, r.End.Sub(r.Start)
+}
+
+// TaskTimeInStatus reports how long a task has spent in each status.
+type TaskTimeInStatus struct {
+	CurrentStatus StatusHistory   `json:"current_status"`
+	StatusHistory []StatusHistory `json:"status_history"`
+}
+
+// StatusHistory is the time a task spent in one status. Since is when the task
+// first entered the status, or for the current status when it last did.
+type StatusHistory struct {
+	Status     string        `json:"status"`
+	Color      string        `json:"color"`
+	Type       string        `json:"type"`
+	OrderIndex int64         `json:"orderindex"`
+	TotalTime  time.Duration `json:"-"`
+	Since      Timestamp     `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, flattening the
+// total_time object ClickUp reports in whole minutes.
+func (h *StatusHistory) UnmarshalJSON(data []byte) error {
+	type aliasStatusHistory StatusHistory // avoid infinite recursion by using type alias.
+	aux := struct {
+		*aliasStatusHistory
+		TotalTime struct {
+			ByMinute int64     `json:"by_minute"`
+			Since    Timestamp `json:"since"`
+		} `json:"total_time"`
+	}{aliasStatusHistory: (*aliasStatusHistory)(h)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	h.TotalTime = time.Duration(aux.TotalTime.ByMinute) * time.Minute
+	h.Since = aux.TotalTime.Since
+	return nil
+}
+
+type TimeInStatusOptions struct {
+	CustomTaskIDs bool   `url:"custom_task_ids,omitempty"`
+	TeamID        string `url:"team_id,omitempty"`
+}
+
+// maxBulkTimeInStatusTasks is the number of tasks ClickUp accepts in one
+// bulk time in status request.
+const maxBulkTimeInStatusTasks = 100
+
+// TaskDependencyOptions names the other side of a dependency. Exactly one of
+// DependsOn (the task waits on it) or DependencyOf (it waits on the task)
+// should be set.
+type TaskDependencyOptions struct {
+	DependsOn    string `json:"depends_on,omitempty" url:"depends_on,omitempty"`
+	DependencyOf string `json:"dependency_of,omitempty" url:"dependency_of,omitempty"`
 }
 
 type TaskMembersWrapper struct {
@@ -134,8 +303,6 @@ func (s *TasksService) Get(ctx context.Context, taskID string, query string) (*T
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(Task)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -151,8 +318,6 @@ func (s *TasksService) List(ctx context.Context, listID string, query string) (*
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(TasksWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -168,8 +333,6 @@ func (s *TasksService) ForTeam(ctx context.Context, teamID string, query string)
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(TasksWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -185,8 +348,6 @@ func (s *TasksService) Members(ctx context.Context, taskID string, query string)
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(TaskMembersWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -203,8 +364,6 @@ func (s *TasksService) Comments(ctx context.Context, taskID string, query string
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(TaskCommentsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -214,3 +373,224 @@ func (s *TasksService) Comments(ctx context.Context, taskID string, query string
 	return wResp, resp, nil
 
 }
+
+func (s *TasksService) AddTag(ctx context.Context, taskID string, tagName string, query string) (*Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("task/%s/tag/%s%s", taskID, url.PathEscape(tagName), query), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TasksService) RemoveTag(ctx context.Context, taskID string, tagName string, query string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("task/%s/tag/%s%s", taskID, url.PathEscape(tagName), query), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TasksService) AddDependency(ctx context.Context, taskID string, opts *TaskDependencyOptions) (*Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("task/%s/dependency", taskID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TasksService) DeleteDependency(ctx context.Context, taskID string, opts *TaskDependencyOptions) (*Response, error) {
+	u, err := addOptions(fmt.Sprintf("task/%s/dependency", taskID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TasksService) AddTaskLink(ctx context.Context, taskID string, linksTo string) (*Task, *Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("task/%s/link/%s", taskID, linksTo), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(TaskWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &wResp.Task, resp, nil
+}
+
+func (s *TasksService) DeleteTaskLink(ctx context.Context, taskID string, linksTo string) (*Task, *Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("task/%s/link/%s", taskID, linksTo), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(TaskWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &wResp.Task, resp, nil
+}
+
+func (s *TasksService) TrackedTime(ctx context.Context, taskID string, query string) (*TrackedTimeWrapper, *Response, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("task/%s/time%s", taskID, query), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(TrackedTimeWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wResp, resp, nil
+}
+
+// TrackTime records an interval of time on a task for the authenticated user.
+// The returned interval carries the ID ClickUp assigned to it.
+func (s *TasksService) TrackTime(ctx context.Context, taskID string, interval *TrackTimeRequest, query string) (*TimeInterval, *Response, error) {
+	if interval == nil {
+		return nil, nil, errNilInterval
+	}
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("task/%s/time%s", taskID, query), interval)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(struct {
+		ID string `json:"id"`
+	})
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	end, d := interval.interval()
+	return &TimeInterval{
+		ID:    wResp.ID,
+		Start: Timestamp{interval.Start},
+		End:   Timestamp{end},
+		Time:  d,
+	}, resp, nil
+}
+
+func (s *TasksService) EditTrackedTime(ctx context.Context, taskID string, intervalID string, interval *TrackTimeRequest, query string) (*Response, error) {
+	if interval == nil {
+		return nil, errNilInterval
+	}
+	req, err := s.client.NewRequest("PUT", fmt.Sprintf("task/%s/time/%s%s", taskID, intervalID, query), interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TasksService) DeleteTrackedTime(ctx context.Context, taskID string, intervalID string, query string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("task/%s/time/%s%s", taskID, intervalID, query), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TasksService) TimeInStatus(ctx context.Context, taskID string, opts *TimeInStatusOptions) (*TaskTimeInStatus, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("task/%s/time_in_status", taskID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(TaskTimeInStatus)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wResp, resp, nil
+}
+
+// BulkTimeInStatus returns the time in status of several tasks keyed by task
+// ID. ClickUp accepts at most 100 tasks per request, so larger sets are split
+// into several requests and merged; the Response is that of the last one.
+func (s *TasksService) BulkTimeInStatus(ctx context.Context, taskIDs []string, opts *TimeInStatusOptions) (map[string]TaskTimeInStatus, *Response, error) {
+	if opts == nil {
+		opts = &TimeInStatusOptions{}
+	}
+
+	result := make(map[string]TaskTimeInStatus, len(taskIDs))
+	var resp *Response
+	for len(taskIDs) > 0 {
+		n := len(taskIDs)
+		if n > maxBulkTimeInStatusTasks {
+			n = maxBulkTimeInStatusTasks
+		}
+
+		u, err := addOptions("task/bulk_time_in_status/task_ids", &struct {
+			TaskIDs []string `url:"task_ids"`
+			*TimeInStatusOptions
+		}{taskIDs[:n], opts})
+		if err != nil {
+			return nil, resp, err
+		}
+
+		req, err := s.client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		chunk := make(map[string]TaskTimeInStatus, n)
+		resp, err = s.client.Do(ctx, req, &chunk)
+		if err != nil {
+			return nil, resp, err
+		}
+		for id, tis := range chunk {
+			result[id] = tis
+		}
+		taskIDs = taskIDs[n:]
+	}
+
+	return result, resp, nil
+}
+
+// CreateFromTemplate creates a task called name in a list from a task
+// template.
+func (s *TasksService) CreateFromTemplate(ctx context.Context, listID string, templateID string, name string) (*Task, *Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("list/%s/taskTemplate/%s", listID, templateID), &templateRequest{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(struct {
+		ID   string `json:"id"`
+		Task Task   `json:"task"`
+	})
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if wResp.Task.ID == "" {
+		wResp.Task.ID = wResp.ID
+	}
+	return &wResp.Task, resp, nil
+}
@@ -0,0 +1,270 @@
+package clickup_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+func TestTrackTimeNilInterval(t *testing.T) {
+	srv, client, _ := setup(t)
+	defer srv.Close()
+	ctx := context.Background()
+
+	if _, _, err := client.Tasks.TrackTime(ctx, "1", nil, ""); err == nil {
+		t.Error("TrackTime with a nil interval succeeded")
+	}
+	if _, err := client.Tasks.EditTrackedTime(ctx, "1", "2", nil, ""); err == nil {
+		t.Error("EditTrackedTime with a nil interval succeeded")
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	list := srv.AddList(srv.AddSpace(team, "Engineering"), "", "Backlog")
+	design := srv.AddTask(list, clickuptest.Task{Name: "Design"})
+	build := srv.AddTask(list, clickuptest.Task{Name: "Build"})
+	ship := srv.AddTask(list, clickuptest.Task{Name: "Ship"})
+	ctx := context.Background()
+
+	if _, err := client.Tasks.AddDependency(ctx, build, &clickup.TaskDependencyOptions{DependsOn: design}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Tasks.AddDependency(ctx, build, &clickup.TaskDependencyOptions{DependencyOf: ship}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Tasks.AddDependency(ctx, build, &clickup.TaskDependencyOptions{DependsOn: build}); err == nil {
+		t.Error("a task could depend on itself")
+	}
+	if _, err := client.Tasks.AddDependency(ctx, build, &clickup.TaskDependencyOptions{}); err == nil {
+		t.Error("AddDependency without a task succeeded")
+	}
+
+	task, _, err := client.Tasks.Get(ctx, build, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []clickup.TaskDependency{
+		{TaskID: build, DependsOn: design, Type: 1},
+		{TaskID: ship, DependsOn: build, Type: 0},
+	}
+	if len(task.Dependencies) != len(want) {
+		t.Fatalf("dependencies = %+v, want %+v", task.Dependencies, want)
+	}
+	for i, d := range task.Dependencies {
+		if d.TaskID != want[i].TaskID || d.DependsOn != want[i].DependsOn || d.Type != want[i].Type || d.UserID != "1" {
+			t.Errorf("dependency %d = %+v, want %+v", i, d, want[i])
+		}
+	}
+
+	// The dependency shows on both tasks, from the side of each.
+	task, _, err = client.Tasks.Get(ctx, ship, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(task.Dependencies) != 1 || task.Dependencies[0].Type != 1 {
+		t.Errorf("dependencies of the waiting task = %+v", task.Dependencies)
+	}
+
+	if _, err := client.Tasks.DeleteDependency(ctx, ship, &clickup.TaskDependencyOptions{DependsOn: build}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Tasks.DeleteDependency(ctx, ship, &clickup.TaskDependencyOptions{DependsOn: build}); err == nil {
+		t.Error("deleting a missing dependency succeeded")
+	}
+	task, _, err = client.Tasks.Get(ctx, build, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(task.Dependencies) != 1 || task.Dependencies[0].DependsOn != design {
+		t.Errorf("dependencies after delete = %+v", task.Dependencies)
+	}
+}
+
+func TestTaskLinks(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	list := srv.AddList(srv.AddSpace(team, "Engineering"), "", "Backlog")
+	bug := srv.AddTask(list, clickuptest.Task{Name: "Bug"})
+	fix := srv.AddTask(list, clickuptest.Task{Name: "Fix"})
+	ctx := context.Background()
+
+	task, _, err := client.Tasks.AddTaskLink(ctx, bug, fix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(task.LinkedTasks) != 1 || task.LinkedTasks[0].TaskID != bug || task.LinkedTasks[0].LinkID != fix {
+		t.Errorf("linked tasks = %+v", task.LinkedTasks)
+	}
+	// Links go both ways.
+	task, _, err = client.Tasks.Get(ctx, fix, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(task.LinkedTasks) != 1 || task.LinkedTasks[0].TaskID != fix || task.LinkedTasks[0].LinkID != bug {
+		t.Errorf("linked tasks of the other task = %+v", task.LinkedTasks)
+	}
+	if _, _, err := client.Tasks.AddTaskLink(ctx, fix, bug); err != nil {
+		t.Fatal(err)
+	}
+
+	task, _, err = client.Tasks.DeleteTaskLink(ctx, fix, bug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(task.LinkedTasks) != 0 {
+		t.Errorf("linked tasks after delete = %+v", task.LinkedTasks)
+	}
+	if _, _, err := client.Tasks.DeleteTaskLink(ctx, fix, bug); err == nil {
+		t.Error("deleting a missing link succeeded")
+	}
+}
+
+func TestTrackedTime(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	list := srv.AddList(srv.AddSpace(team, "Engineering"), "", "Backlog")
+	task := srv.AddTask(list, clickuptest.Task{Name: "Design"})
+	ctx := context.Background()
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	first, _, err := client.Tasks.TrackTime(ctx, task, &clickup.TrackTimeRequest{Start: start, Duration: time.Hour}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID == "" || !first.End.Time.Equal(start.Add(time.Hour)) {
+		t.Errorf("tracked interval = %+v", first)
+	}
+	second, _, err := client.Tasks.TrackTime(ctx, task, &clickup.TrackTimeRequest{Start: start.Add(2 * time.Hour), End: start.Add(150 * time.Minute)}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Tasks.TrackTime(ctx, task, &clickup.TrackTimeRequest{Start: start}, ""); err == nil {
+		t.Error("tracking an empty interval succeeded")
+	}
+
+	tracked, _, err := client.Tasks.TrackedTime(ctx, task, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tracked.TrackedTime) != 1 {
+		t.Fatalf("tracked time = %+v, want one user", tracked.TrackedTime)
+	}
+	if got := tracked.TrackedTime[0]; got.User.ID != 1 || got.Time != 90*time.Minute || len(got.Intervals) != 2 {
+		t.Errorf("tracked time = %+v", got)
+	}
+	if iv := tracked.TrackedTime[0].Intervals[1]; iv.ID != second.ID || iv.Time != 30*time.Minute || !iv.Start.Time.Equal(start.Add(2*time.Hour)) {
+		t.Errorf("second interval = %+v", iv)
+	}
+	got, _, err := client.Tasks.Get(ctx, task, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.TimeSpent != float64(90*time.Minute/time.Millisecond) {
+		t.Errorf("time spent = %v, want 90 minutes", got.TimeSpent)
+	}
+
+	if _, err := client.Tasks.EditTrackedTime(ctx, task, first.ID, &clickup.TrackTimeRequest{Start: start, Duration: 2 * time.Hour}, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Tasks.DeleteTrackedTime(ctx, task, second.ID, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Tasks.DeleteTrackedTime(ctx, task, second.ID, ""); err == nil {
+		t.Error("deleting a deleted interval succeeded")
+	}
+	tracked, _, err = client.Tasks.TrackedTime(ctx, task, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tracked.TrackedTime[0]; got.Time != 2*time.Hour || len(got.Intervals) != 1 {
+		t.Errorf("tracked time after edit and delete = %+v", got)
+	}
+}
+
+func TestStatusHistoryUnmarshal(t *testing.T) {
+	var h clickup.StatusHistory
+	data := `{"status":"in progress","color":"#4194f6","type":"custom","orderindex":1,"total_time":{"by_minute":90,"since":"1700000000000"}}`
+	if err := json.Unmarshal([]byte(data), &h); err != nil {
+		t.Fatal(err)
+	}
+	if h.Status != "in progress" || h.Type != "custom" || h.OrderIndex != 1 || h.TotalTime != 90*time.Minute || !h.Since.Time.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("decoded %+v", h)
+	}
+}
+
+// moveTask sets the status of the task id through the API.
+func moveTask(t *testing.T, client *clickup.Client, id, status string) {
+	t.Helper()
+	req, err := client.NewRequest("PUT", "task/"+id, map[string]string{"status": status})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTimeInStatus(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	start := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	now := start
+	srv.Now = func() time.Time { return now }
+	list := srv.AddList(srv.AddSpace(team, "Engineering"), "", "Backlog")
+	id := srv.AddTask(list, clickuptest.Task{Name: "Build"})
+
+	now = now.Add(30 * time.Minute)
+	moveTask(t, client, id, "in progress")
+	now = now.Add(15 * time.Minute)
+	moveTask(t, client, id, "to do")
+	now = now.Add(10 * time.Minute)
+
+	tis, _, err := client.Tasks.TimeInStatus(context.Background(), id, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := tis.CurrentStatus; c.Status != "to do" || c.TotalTime != 10*time.Minute || !c.Since.Time.Equal(start.Add(45*time.Minute)) {
+		t.Errorf("current status = %+v, want to do for 10m since 9:45", c)
+	}
+	if h := tis.StatusHistory; len(h) != 2 ||
+		h[0].Status != "to do" || h[0].TotalTime != 40*time.Minute || !h[0].Since.Time.Equal(start) ||
+		h[1].Status != "in progress" || h[1].OrderIndex != 1 || h[1].TotalTime != 15*time.Minute {
+		t.Errorf("status history = %+v, want to do for 40m then in progress for 15m", h)
+	}
+}
+
+func TestBulkTimeInStatus(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	list := srv.AddList(srv.AddSpace(team, "Engineering"), "", "Backlog")
+	var ids []string
+	for i := 0; i < 150; i++ {
+		ids = append(ids, srv.AddTask(list, clickuptest.Task{Name: "Task"}))
+	}
+	moveTask(t, client, ids[120], "complete")
+
+	requests := 0
+	client.OnRequest = func(ctx context.Context, info *clickup.RequestInfo) { requests++ }
+	result, _, err := client.Tasks.BulkTimeInStatus(context.Background(), ids, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("made %d requests for 150 tasks, want 2", requests)
+	}
+	if len(result) != 150 {
+		t.Errorf("got time in status of %d tasks, want 150", len(result))
+	}
+	if got := result[ids[0]].CurrentStatus.Status; got != "to do" {
+		t.Errorf("first task is in %q, want to do", got)
+	}
+	if got := result[ids[120]].CurrentStatus.Status; got != "complete" {
+		t.Errorf("task 120 is in %q, want complete", got)
+	}
+}
@@ -14,9 +14,19 @@ func (t Timestamp) String() string {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339 or Unix format.
+// Time is expected in RFC3339 or Unix format. ClickUp sends most Unix times as
+// quoted milliseconds, so a quoted number is accepted too, and null or an
+// empty string leave t unchanged.
 func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 	str := string(data)
+	if str == "null" || str == `""` {
+		return nil
+	}
+	if unquoted, uerr := strconv.Unquote(str); uerr == nil {
+		if _, perr := strconv.ParseInt(unquoted, 10, 64); perr == nil {
+			str = unquoted
+		}
+	}
 	i, err := strconv.ParseInt(str, 10, 64)
 	if err == nil {
 		t.Time = time.Unix(i, 0)
@@ -0,0 +1,395 @@
+package clickup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type TimeTrackingService service
+
+type TimeEntriesWrapper struct {
+	TimeEntries []TimeEntry `json:"data"`
+}
+
+type TimeEntry struct {
+	ID   string `json:"id"`
+	Task *struct {
+		ID     string `json:"id"`
+		Name   string `json:"name"`
+		Status struct {
+			Status     string `json:"status"`
+			Color      string `json:"color"`
+			Type       string `json:"type"`
+			OrderIndex int64  `json:"orderindex"`
+		} `json:"status"`
+		CustomType interface{} `json:"custom_type"`
+	} `json:"task"`
+	WorkspaceID string `json:"wid"`
+	User        struct {
+		ID             int64  `json:"id"`
+		Username       string `json:"username"`
+		Email          string `json:"email"`
+		Color          string `json:"color"`
+		Initials       string `json:"initials"`
+		ProfilePicture string `json:"profilePicture"`
+	} `json:"user"`
+	Billable bool      `json:"billable"`
+	Start    Timestamp `json:"start"`
+	End      Timestamp `json:"end"`
+	// Duration is negative while the timer is running, see Elapsed.
+	Duration     time.Duration `json:"duration"`
+	Description  string        `json:"description"`
+	Tags         []Tag         `json:"tags"`
+	Source       string        `json:"source"`
+	At           Timestamp     `json:"at"`
+	TaskLocation struct {
+		ListID     string `json:"list_id"`
+		FolderID   string `json:"folder_id"`
+		SpaceID    string `json:"space_id"`
+		ListName   string `json:"list_name"`
+		FolderName string `json:"folder_name"`
+		SpaceName  string `json:"space_name"`
+	} `json:"task_location"`
+	TaskTags []Tag  `json:"task_tags"`
+	TaskURL  string `json:"task_url"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. ClickUp sends the
+// duration in milliseconds, as a number or a quoted number.
+func (e *TimeEntry) UnmarshalJSON(data []byte) error {
+	type aliasTimeEntry TimeEntry // avoid infinite recursion by using type alias.
+	aux := struct {
+		*aliasTimeEntry
+		Duration json.RawMessage `json:"duration"`
+	}{aliasTimeEntry: (*aliasTimeEntry)(e)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	d, err := parseMilliseconds(aux.Duration)
+	if err != nil {
+		return err
+	}
+	e.Duration = d
+	return nil
+}
+
+// Elapsed returns the duration of the entry, measuring running timers up to
+// now.
+func (e *TimeEntry) Elapsed(now time.Time) time.Duration {
+	if e.Duration < 0 {
+		return now.Sub(e.Start.Time)
+	}
+	return e.Duration
+}
+
+type TimeEntryHistoryWrapper struct {
+	History []TimeEntryHistory `json:"data"`
+}
+
+type TimeEntryHistory struct {
+	ID     string      `json:"id"`
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+	User   struct {
+		ID             int64  `json:"id"`
+		Username       string `json:"username"`
+		Email          string `json:"email"`
+		Color          string `json:"color"`
+		Initials       string `json:"initials"`
+		ProfilePicture string `json:"profilePicture"`
+	} `json:"user"`
+	Date   Timestamp `json:"date"`
+	Source string    `json:"source"`
+}
+
+type TimeEntryTagsWrapper struct {
+	Tags []Tag `json:"data"`
+}
+
+// TimeEntryOptions filters the time entries returned by ListEntries. Without a
+// date range ClickUp returns the last 30 days, and without Assignee only the
+// entries of the authenticated user. Only one of SpaceID, FolderID, ListID
+// and TaskID may be set.
+type TimeEntryOptions struct {
+	StartDate            time.Time `url:"start_date,unixmilli,omitempty"`
+	EndDate              time.Time `url:"end_date,unixmilli,omitempty"`
+	Assignee             []int64   `url:"assignee,comma,omitempty"`
+	IncludeTaskTags      bool      `url:"include_task_tags,omitempty"`
+	IncludeLocationNames bool      `url:"include_location_names,omitempty"`
+	SpaceID              string    `url:"space_id,omitempty"`
+	FolderID             string    `url:"folder_id,omitempty"`
+	ListID               string    `url:"list_id,omitempty"`
+	TaskID               string    `url:"task_id,omitempty"`
+	CustomTaskIDs        bool      `url:"custom_task_ids,omitempty"`
+	TeamID               string    `url:"team_id,omitempty"`
+}
+
+// TimeEntryRequest is the body used to start, create and update time entries.
+// Zero fields are left out.
+type TimeEntryRequest struct {
+	Description string
+	Tags        []Tag
+	// TagAction is "add" or "remove" and applies Tags on Update.
+	TagAction string
+	Start     time.Time
+	End       time.Time
+	Duration  time.Duration
+	Billable  *bool
+	Assignee  int64
+	TaskID    string
+}
+
+// MarshalJSON implements the json.Marshaler interface, sending times and
+// durations as milliseconds like ClickUp expects.
+func (r *TimeEntryRequest) MarshalJSON() ([]byte, error) {
+	body := struct {
+		Description string `json:"description,omitempty"`
+		Tags        []Tag  `json:"tags,omitempty"`
+		TagAction   string `json:"tag_action,omitempty"`
+		Start       int64  `json:"start,omitempty"`
+		End         int64  `json:"end,omitempty"`
+		Duration    int64  `json:"duration,omitempty"`
+		Billable    *bool  `json:"billable,omitempty"`
+		Assignee    int64  `json:"assignee,omitempty"`
+		TaskID      string `json:"tid,omitempty"`
+	}{
+		Description: r.Description,
+		Tags:        r.Tags,
+		TagAction:   r.TagAction,
+		Duration:    r.Duration.Milliseconds(),
+		Billable:    r.Billable,
+		Assignee:    r.Assignee,
+		TaskID:      r.TaskID,
+	}
+	if !r.Start.IsZero() {
+		body.Start = r.Start.UnixNano() / int64(time.Millisecond)
+	}
+	if !r.End.IsZero() {
+		body.End = r.End.UnixNano() / int64(time.Millisecond)
+	}
+	return json.Marshal(body)
+}
+
+type timeEntryTagsRequest struct {
+	TimeEntryIDs []string `json:"time_entry_ids"`
+	Tags         []Tag    `json:"tags"`
+}
+
+// timeEntryWrapper decodes the single entry ClickUp wraps in "data", which is
+// an object on some endpoints and a one element array on others.
+type timeEntryWrapper struct {
+	Data json.RawMessage `json:"data"`
+}
+
+func (w *timeEntryWrapper) entry() (*TimeEntry, error) {
+	data := bytes.TrimSpace(w.Data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+	if data[0] == '[' {
+		var entries []TimeEntry
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return nil, err
+		}
+		if len(entries) == 0 {
+			return nil, nil
+		}
+		return &entries[0], nil
+	}
+	entry := new(TimeEntry)
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func parseMilliseconds(data json.RawMessage) (time.Duration, error) {
+	str := string(bytes.TrimSpace(data))
+	if str == "" || str == "null" || str == `""` {
+		return 0, nil
+	}
+	if unquoted, err := strconv.Unquote(str); err == nil {
+		str = unquoted
+	}
+	ms, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %s: %v", data, err)
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+func (s *TimeTrackingService) ListEntries(ctx context.Context, teamID string, opts *TimeEntryOptions) (*TimeEntriesWrapper, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("team/%s/time_entries", teamID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(TimeEntriesWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wResp, resp, nil
+}
+
+func (s *TimeTrackingService) Get(ctx context.Context, teamID string, timerID string, query string) (*TimeEntry, *Response, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("team/%s/time_entries/%s%s", teamID, timerID, query), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doEntry(ctx, req)
+}
+
+func (s *TimeTrackingService) History(ctx context.Context, teamID string, timerID string) (*TimeEntryHistoryWrapper, *Response, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("team/%s/time_entries/%s/history", teamID, timerID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(TimeEntryHistoryWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wResp, resp, nil
+}
+
+// Running returns the running time entry of assignee, or of the authenticated
+// user when assignee is 0. The entry is nil when no timer is running.
+func (s *TimeTrackingService) Running(ctx context.Context, teamID string, assignee int64) (*TimeEntry, *Response, error) {
+	u := fmt.Sprintf("team/%s/time_entries/current", teamID)
+	if assignee != 0 {
+		u += fmt.Sprintf("?assignee=%d", assignee)
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doEntry(ctx, req)
+}
+
+func (s *TimeTrackingService) Start(ctx context.Context, teamID string, entry *TimeEntryRequest) (*TimeEntry, *Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("team/%s/time_entries/start", teamID), entry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doEntry(ctx, req)
+}
+
+func (s *TimeTrackingService) Stop(ctx context.Context, teamID string) (*TimeEntry, *Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("team/%s/time_entries/stop", teamID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doEntry(ctx, req)
+}
+
+func (s *TimeTrackingService) Create(ctx context.Context, teamID string, entry *TimeEntryRequest) (*TimeEntry, *Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("team/%s/time_entries", teamID), entry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.doEntry(ctx, req)
+}
+
+func (s *TimeTrackingService) Update(ctx context.Context, teamID string, timerID string, entry *TimeEntryRequest) (*Response, error) {
+	req, err := s.client.NewRequest("PUT", fmt.Sprintf("team/%s/time_entries/%s", teamID, timerID), entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TimeTrackingService) Delete(ctx context.Context, teamID string, timerID string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("team/%s/time_entries/%s", teamID, timerID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Tags lists every tag used on time entries in the workspace.
+func (s *TimeTrackingService) Tags(ctx context.Context, teamID string) (*TimeEntryTagsWrapper, *Response, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("team/%s/time_entries/tags", teamID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(TimeEntryTagsWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wResp, resp, nil
+}
+
+func (s *TimeTrackingService) AddTags(ctx context.Context, teamID string, timerIDs []string, tags []Tag) (*Response, error) {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("team/%s/time_entries/tags", teamID), &timeEntryTagsRequest{TimeEntryIDs: timerIDs, Tags: tags})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TimeTrackingService) RemoveTags(ctx context.Context, teamID string, timerIDs []string, tags []Tag) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("team/%s/time_entries/tags", teamID), &timeEntryTagsRequest{TimeEntryIDs: timerIDs, Tags: tags})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// EditTag renames the time entry tag called tagName and sets its colors.
+func (s *TimeTrackingService) EditTag(ctx context.Context, teamID string, tagName string, tag Tag) (*Response, error) {
+	body := struct {
+		Name            string `json:"name"`
+		NewName         string `json:"new_name"`
+		BackgroundColor string `json:"tag_bg"`
+		ForegroundColor string `json:"tag_fg"`
+	}{tagName, tag.Name, tag.BackgroundColor, tag.ForegroundColor}
+	req, err := s.client.NewRequest("PUT", fmt.Sprintf("team/%s/time_entries/tags", teamID), &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TimeTrackingService) doEntry(ctx context.Context, req *http.Request) (*TimeEntry, *Response, error) {
+	wResp := new(timeEntryWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	entry, err := wResp.entry()
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return entry, resp, nil
+}
@@ -0,0 +1,169 @@
+package clickup_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/catdevman/go-clickup/clickup"
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+func TestTimeEntries(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	space := srv.AddSpace(team, "Engineering")
+	list := srv.AddList(space, srv.AddFolder(space, "Q1"), "Backlog")
+	task := srv.AddTask(list, clickuptest.Task{Name: "Design", Tags: []string{"ux"}})
+	other := srv.AddUser(team, clickuptest.User{Username: "Jane Doe"})
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Millisecond)
+	billable := true
+
+	created, _, err := client.TimeTracking.Create(ctx, team, &clickup.TimeEntryRequest{
+		Description: "Sketches",
+		Start:       now.Add(-3 * time.Hour),
+		Duration:    time.Hour,
+		Billable:    &billable,
+		TaskID:      task,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == "" || created.Duration != time.Hour || !created.End.Time.Equal(now.Add(-2*time.Hour)) || !created.Billable || created.Task.ID != task {
+		t.Errorf("created entry = %+v", created)
+	}
+	if _, _, err := client.TimeTracking.Create(ctx, team, &clickup.TimeEntryRequest{Start: now, Assignee: other}); err == nil {
+		t.Error("creating an entry without a duration succeeded")
+	}
+	if _, _, err := client.TimeTracking.Create(ctx, team, &clickup.TimeEntryRequest{Start: now.Add(-time.Hour), Duration: time.Minute, Assignee: other}); err != nil {
+		t.Fatal(err)
+	}
+
+	running, _, err := client.TimeTracking.Running(ctx, team, 0)
+	if err != nil || running != nil {
+		t.Fatalf("Running with no timer = %+v, %v", running, err)
+	}
+	started, _, err := client.TimeTracking.Start(ctx, team, &clickup.TimeEntryRequest{Description: "Review", TaskID: task})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if started.Duration >= 0 || started.Elapsed(time.Now()) < 0 {
+		t.Errorf("started entry has duration %v, want a running timer", started.Duration)
+	}
+	running, _, err = client.TimeTracking.Running(ctx, team, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if running == nil || running.ID != started.ID {
+		t.Errorf("running entry = %+v, want %s", running, started.ID)
+	}
+
+	entries, _, err := client.TimeTracking.ListEntries(ctx, team, &clickup.TimeEntryOptions{IncludeLocationNames: true, IncludeTaskTags: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries.TimeEntries) != 2 {
+		t.Fatalf("listed %d entries, want the 2 of the authenticated user", len(entries.TimeEntries))
+	}
+	if e := entries.TimeEntries[0]; e.TaskLocation.ListName != "Backlog" || e.TaskLocation.FolderName != "Q1" || e.TaskLocation.SpaceID != space || len(e.TaskTags) != 1 {
+		t.Errorf("entry location = %+v, task tags %+v", e.TaskLocation, e.TaskTags)
+	}
+	entries, _, err = client.TimeTracking.ListEntries(ctx, team, &clickup.TimeEntryOptions{Assignee: []int64{1, other}, StartDate: now.Add(-90 * time.Minute), EndDate: now.Add(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries.TimeEntries) != 2 {
+		t.Errorf("listed %d entries started in the range, want 2", len(entries.TimeEntries))
+	}
+	entries, _, err = client.TimeTracking.ListEntries(ctx, team, &clickup.TimeEntryOptions{Assignee: []int64{other}, TaskID: task})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries.TimeEntries) != 0 {
+		t.Errorf("listed %d entries of another task", len(entries.TimeEntries))
+	}
+
+	stopped, _, err := client.TimeTracking.Stop(ctx, team)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stopped.ID != started.ID || stopped.Duration < 0 {
+		t.Errorf("stopped entry = %+v", stopped)
+	}
+	if _, _, err := client.TimeTracking.Stop(ctx, team); err == nil {
+		t.Error("stopping without a running timer succeeded")
+	}
+
+	if _, err := client.TimeTracking.Update(ctx, team, created.ID, &clickup.TimeEntryRequest{Description: "Wireframes", Start: now.Add(-4 * time.Hour), Duration: 2 * time.Hour}); err != nil {
+		t.Fatal(err)
+	}
+	got, _, err := client.TimeTracking.Get(ctx, team, created.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Description != "Wireframes" || got.Duration != 2*time.Hour || !got.Start.Time.Equal(now.Add(-4*time.Hour)) {
+		t.Errorf("updated entry = %+v", got)
+	}
+	if _, err := client.TimeTracking.Delete(ctx, team, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.TimeTracking.Get(ctx, team, created.ID, ""); err == nil {
+		t.Error("getting a deleted entry succeeded")
+	}
+}
+
+func TestTimeEntryTags(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	ctx := context.Background()
+	now := time.Now()
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		e, _, err := client.TimeTracking.Create(ctx, team, &clickup.TimeEntryRequest{Start: now.Add(-time.Duration(i+1) * time.Hour), Duration: time.Minute})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, e.ID)
+	}
+
+	if _, err := client.TimeTracking.AddTags(ctx, team, ids, []clickup.Tag{{Name: "meeting", BackgroundColor: "#ff0000"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.TimeTracking.AddTags(ctx, team, ids[:1], []clickup.Tag{{Name: "client"}}); err != nil {
+		t.Fatal(err)
+	}
+	tags, _, err := client.TimeTracking.Tags(ctx, team)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tagNames(tags.Tags); len(got) != 2 || got[0] != "meeting" || got[1] != "client" {
+		t.Errorf("tags = %q", got)
+	}
+
+	if _, err := client.TimeTracking.EditTag(ctx, team, "meeting", clickup.Tag{Name: "Meeting", BackgroundColor: "#00ff00", ForegroundColor: "#ffffff"}); err != nil {
+		t.Fatal(err)
+	}
+	e, _, err := client.TimeTracking.Get(ctx, team, ids[1], "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(e.Tags) != 1 || e.Tags[0].Name != "Meeting" || e.Tags[0].BackgroundColor != "#00ff00" {
+		t.Errorf("tags of the entry after the rename = %+v", e.Tags)
+	}
+
+	if _, err := client.TimeTracking.RemoveTags(ctx, team, ids[:1], []clickup.Tag{{Name: "client"}}); err != nil {
+		t.Fatal(err)
+	}
+	tags, _, err = client.TimeTracking.Tags(ctx, team)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tagNames(tags.Tags); len(got) != 1 || got[0] != "Meeting" {
+		t.Errorf("tags after removing client = %q", got)
+	}
+	if _, err := client.TimeTracking.AddTags(ctx, team, []string{"missing"}, []clickup.Tag{{Name: "x"}}); err == nil {
+		t.Error("tagging a missing entry succeeded")
+	}
+}
@@ -0,0 +1,139 @@
+package clickup
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Tracer starts spans around API calls. It mirrors the shape of an
+// OpenTelemetry tracer so that an adapter is a few lines:
+//
+//	type otelTracer struct{ t trace.Tracer }
+//
+//	func (o otelTracer) Start(ctx context.Context, name string) (context.Context, clickup.Span) {
+//		ctx, span := o.t.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, otelSpan{span}
+//	}
+//
+// where otelSpan converts Attributes to attribute.KeyValue.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a single traced operation.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Attribute is a key/value pair recorded on a span. Value is a string, int,
+// int64 or bool.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Span attribute keys set on request spans.
+const (
+	AttrHTTPMethod         = "http.request.method"
+	AttrHTTPStatusCode     = "http.response.status_code"
+	AttrURL                = "url.full"
+	AttrEndpoint           = "clickup.endpoint"
+	AttrWorkspaceID        = "clickup.workspace_id"
+	AttrSpaceID            = "clickup.space_id"
+	AttrFolderID           = "clickup.folder_id"
+	AttrListID             = "clickup.list_id"
+	AttrTaskID             = "clickup.task_id"
+	AttrRetryAttempt       = "clickup.retry_attempt"
+	AttrRateLimitLimit     = "clickup.rate_limit.limit"
+	AttrRateLimitRemaining = "clickup.rate_limit.remaining"
+	AttrRateLimitReset     = "clickup.rate_limit.reset"
+)
+
+// idAttributes maps the path segment preceding an ID to the attribute the ID
+// is recorded as.
+var idAttributes = map[string]string{
+	"team":   AttrWorkspaceID,
+	"space":  AttrSpaceID,
+	"folder": AttrFolderID,
+	"list":   AttrListID,
+	"task":   AttrTaskID,
+}
+
+type retryAttemptKey struct{}
+
+// WithRetryAttempt returns a copy of ctx marking requests made with it as the
+// given retry attempt, which is recorded on their spans. The first try is
+// attempt 0.
+func WithRetryAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, retryAttemptKey{}, attempt)
+}
+
+func retryAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(retryAttemptKey{}).(int)
+	return attempt
+}
+
+// StartSpan starts a span with the client's Tracer, returning a no-op span if
+// none is set. Requests made with the returned context are traced as its
+// children, which is how paginated crawls are grouped under one span.
+func (c *Client) StartSpan(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	if c.Tracer == nil {
+		return ctx, noopSpan{}
+	}
+	ctx, span := c.Tracer.Start(ctx, name)
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+	return ctx, span
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(attrs ...Attribute) {}
+func (noopSpan) RecordError(err error)            {}
+func (noopSpan) End()                             {}
+
+// startRequestSpan starts the span of an API call. The returned function ends
+// it once the response is known.
+func (c *Client) startRequestSpan(ctx context.Context, req *http.Request) (context.Context, func(*Response, error)) {
+	endpoint := c.endpointTemplate(req.URL.Path)
+	ctx, span := c.Tracer.Start(ctx, req.Method+" "+endpoint)
+
+	attrs := []Attribute{
+		{AttrHTTPMethod, req.Method},
+		{AttrURL, sanitizeURL(req.URL).String()},
+		{AttrEndpoint, endpoint},
+	}
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, c.BaseURL.Path), "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if key, ok := idAttributes[segments[i]]; ok && !staticSegments[segments[i+1]] {
+			attrs = append(attrs, Attribute{key, segments[i+1]})
+		}
+	}
+	if attempt := retryAttempt(ctx); attempt > 0 {
+		attrs = append(attrs, Attribute{AttrRetryAttempt, attempt})
+	}
+	span.SetAttributes(attrs...)
+
+	return ctx, func(resp *Response, err error) {
+		if resp != nil && resp.Response != nil {
+			attrs := []Attribute{{AttrHTTPStatusCode, resp.StatusCode}}
+			// A cached response carries the rate limit of when it was
+			// stored.
+			if resp.Header.Get(headerRateLimit) != "" && resp.Header.Get(headerFromCache) == "" {
+				attrs = append(attrs,
+					Attribute{AttrRateLimitLimit, resp.Rate.Limit},
+					Attribute{AttrRateLimitRemaining, resp.Rate.Remaining},
+					Attribute{AttrRateLimitReset, resp.Rate.Reset.Unix()})
+			}
+			span.SetAttributes(attrs...)
+		}
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}
+}
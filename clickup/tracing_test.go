@@ -0,0 +1,53 @@
+package clickup
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (tr *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &testSpan{name: name, attrs: make(map[string]interface{})}
+	tr.spans = append(tr.spans, s)
+	return ctx, s
+}
+
+type testSpan struct {
+	name  string
+	attrs map[string]interface{}
+	ended bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) {}
+func (s *testSpan) End()                  { s.ended = true }
+
+func TestTracingSkipCachedRate(t *testing.T) {
+	srv := rateServer()
+	defer srv.Close()
+	client := NewClient((&CachingTransport{}).Client())
+	tr := &testTracer{}
+	client.Tracer = tr
+	getThroughCache(t, client, srv.URL)
+
+	var got []string
+	for _, s := range tr.spans {
+		if !s.ended {
+			t.Errorf("span %s not ended", s.name)
+		}
+		got = append(got, fmt.Sprintf("%s %v", s.name, s.attrs[AttrRateLimitRemaining]))
+	}
+	want := "[GET team 99 GET user 98 GET team <nil>]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("spans = %v, want %s", got, want)
+	}
+}
@@ -0,0 +1,120 @@
+package clickup
+
+import (
+	"context"
+	"fmt"
+)
+
+type UsersService service
+
+// Role is the role of a user in a workspace.
+type Role int
+
+const (
+	RoleOwner  Role = 1
+	RoleAdmin  Role = 2
+	RoleMember Role = 3
+	RoleGuest  Role = 4
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleOwner:
+		return "owner"
+	case RoleAdmin:
+		return "admin"
+	case RoleMember:
+		return "member"
+	case RoleGuest:
+		return "guest"
+	default:
+		return fmt.Sprintf("Role(%d)", int(r))
+	}
+}
+
+type MemberWrapper struct {
+	Member Member `json:"member"`
+}
+
+type WorkspaceWrapper struct {
+	Workspace Workspace `json:"team"`
+}
+
+// InviteUserRequest is the body of UsersService.Invite. CustomRoleID is only
+// sent when not zero.
+type InviteUserRequest struct {
+	Email        string `json:"email"`
+	Admin        bool   `json:"admin"`
+	CustomRoleID int64  `json:"custom_role_id,omitempty"`
+}
+
+// EditUserRequest is the body of UsersService.Edit.
+type EditUserRequest struct {
+	Username     string `json:"username,omitempty"`
+	Admin        bool   `json:"admin"`
+	CustomRoleID int64  `json:"custom_role_id,omitempty"`
+}
+
+// Invite invites a user to the workspace as a member, or as an admin when
+// admin is set. A customRoleID of 0 assigns no custom role. The returned
+// workspace lists the members including the invited user.
+func (s *UsersService) Invite(ctx context.Context, teamID string, email string, admin bool, customRoleID int64) (*Workspace, *Response, error) {
+	body := &InviteUserRequest{Email: email, Admin: admin, CustomRoleID: customRoleID}
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("team/%s/user", teamID), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(WorkspaceWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &wResp.Workspace, resp, nil
+}
+
+func (s *UsersService) Get(ctx context.Context, teamID string, userID int64) (*Member, *Response, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("team/%s/user/%d", teamID, userID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(MemberWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &wResp.Member, resp, nil
+}
+
+func (s *UsersService) Edit(ctx context.Context, teamID string, userID int64, user *EditUserRequest) (*Member, *Response, error) {
+	req, err := s.client.NewRequest("PUT", fmt.Sprintf("team/%s/user/%d", teamID, userID), user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(MemberWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &wResp.Member, resp, nil
+}
+
+func (s *UsersService) Remove(ctx context.Context, teamID string, userID int64) (*Workspace, *Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("team/%s/user/%d", teamID, userID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wResp := new(WorkspaceWrapper)
+	resp, err := s.client.Do(ctx, req, wResp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &wResp.Workspace, resp, nil
+}
@@ -0,0 +1,88 @@
+package clickup_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup"
+)
+
+func TestRoleString(t *testing.T) {
+	for role, want := range map[clickup.Role]string{
+		clickup.RoleOwner:  "owner",
+		clickup.RoleAdmin:  "admin",
+		clickup.RoleMember: "member",
+		clickup.RoleGuest:  "guest",
+		clickup.Role(7):    "Role(7)",
+	} {
+		if got := role.String(); got != want {
+			t.Errorf("Role(%d).String() = %q, want %q", int(role), got, want)
+		}
+	}
+}
+
+func TestUsers(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	ctx := context.Background()
+
+	ws, _, err := client.Users.Invite(ctx, team, "jane@example.com", true, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var userID int64
+	for _, m := range ws.Members {
+		if m.User.Email == "jane@example.com" {
+			userID = m.User.ID
+			if m.User.Role != clickup.RoleAdmin || m.User.CustomRole == nil || m.User.CustomRole.ID != 42 {
+				t.Errorf("invited user = %+v", m.User)
+			}
+		}
+	}
+	if userID == 0 {
+		t.Fatalf("invited user is not a member: %+v", ws.Members)
+	}
+	if _, _, err := client.Users.Invite(ctx, team, "JANE@example.com", false, 0); err == nil {
+		t.Error("inviting a member again succeeded")
+	}
+	if _, _, err := client.Users.Invite(ctx, team, "jane", false, 0); err == nil {
+		t.Error("inviting an invalid email succeeded")
+	}
+
+	owner, _, err := client.Users.Get(ctx, team, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner.User.Role != clickup.RoleOwner || owner.User.Role.String() != "owner" || owner.User.CustomRole != nil {
+		t.Errorf("owner = %+v", owner.User)
+	}
+
+	m, _, err := client.Users.Edit(ctx, team, userID, &clickup.EditUserRequest{Username: "Jane Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.User.Username != "Jane Doe" || m.User.Role != clickup.RoleMember || m.User.CustomRole == nil || m.User.CustomRole.ID != 42 {
+		t.Errorf("edited user = %+v", m.User)
+	}
+	m, _, err = client.Users.Get(ctx, team, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.User.ID != userID || m.User.Username != "Jane Doe" || m.User.Role.String() != "member" {
+		t.Errorf("user = %+v", m.User)
+	}
+
+	if _, _, err := client.Users.Remove(ctx, team, 1); err == nil {
+		t.Error("removing the owner succeeded")
+	}
+	ws, _, err = client.Users.Remove(ctx, team, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ws.Members) != 1 {
+		t.Errorf("workspace has %d members after removing the user, want 1", len(ws.Members))
+	}
+	if _, _, err := client.Users.Get(ctx, team, userID); err == nil {
+		t.Error("getting a removed user succeeded")
+	}
+}
@@ -12,6 +12,7 @@ type ViewWrapper struct {
 }
 
 type View struct {
+	ID     string `json:"id"`
 	Name   string `json:"name"`
 	Type   string `json:"type"`
 	Parent struct {
@@ -85,13 +86,11 @@ type ChatViewComment struct {
 }
 
 func (s *ViewsService) Get(ctx context.Context, viewID string, query string) (*ViewWrapper, *Response, error) {
-	req, err := s.client.NewRequest("GET", fmt.Sprintf("view/%s%s", query), nil)
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("view/%s%s", viewID, query), nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(ViewWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -102,13 +101,11 @@ func (s *ViewsService) Get(ctx context.Context, viewID string, query string) (*V
 }
 
 func (s *ViewsService) Tasks(ctx context.Context, viewID string, query string) (*TasksWrapper, *Response, error) {
-	req, err := s.client.NewRequest("GET", fmt.Sprintf("view/%s/task%s", query), nil)
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("view/%s/task%s", viewID, query), nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(TasksWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -119,13 +116,11 @@ func (s *ViewsService) Tasks(ctx context.Context, viewID string, query string) (
 }
 
 func (s *ViewsService) Comments(ctx context.Context, viewID string, query string) (*ChatViewCommentsWrapper, *Response, error) {
-	req, err := s.client.NewRequest("GET", fmt.Sprintf("view/%s/comment%s", query), nil)
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("view/%s/comment%s", viewID, query), nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(ChatViewCommentsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
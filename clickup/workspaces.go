@@ -3,6 +3,8 @@ package clickup
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
 )
 
 type WorkspacesService service
@@ -27,8 +29,11 @@ type Member struct {
 		Color          string `json:"color"`
 		ProfilePicture string `json:"profilePicture"`
 		Initials       string `json:"initials"`
-		Role           int    `json:"role"`
-		//CustomRole string???
+		Role           Role   `json:"role"`
+		CustomRole     *struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"custom_role"`
 		LastActive  string `json:"last_active"`
 		DateJoined  string `json:"date_joined"`
 		DateInvited string `json:"date_invited"`
@@ -77,6 +82,11 @@ type TaskTemplate struct {
 	Name string `json:"name"`
 }
 
+// templateRequest is the body used to create an object from a template.
+type templateRequest struct {
+	Name string `json:"name"`
+}
+
 type WebhooksWrapper struct {
 	Webhooks []Webhook `json:"webhooks"`
 }
@@ -132,8 +142,6 @@ func (s *WorkspacesService) Get(ctx context.Context) (*WorkspacesWrapper, *Respo
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(WorkspacesWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -149,8 +157,6 @@ func (s *WorkspacesService) GetSeats(ctx context.Context, workspaceId string) (*
 		return nil, nil, err
 	}
 
-	//	fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(WorkspaceSeats)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -166,8 +172,6 @@ func (s *WorkspacesService) CustomRoles(ctx context.Context, workspaceId string,
 		return nil, nil, err
 	}
 
-	//	fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(CustomRolesWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -177,14 +181,21 @@ func (s *WorkspacesService) CustomRoles(ctx context.Context, workspaceId string,
 	return wResp, resp, nil
 }
 
+// TaskTemplates returns one page of task templates. query may start with "?"
+// or not. ClickUp requires the page parameter, so the first page (page=0) is
+// requested when query has none.
 func (s *WorkspacesService) TaskTemplates(ctx context.Context, workspaceId string, query string) (*TaskTemplatesWrapper, *Response, error) {
+	query = strings.TrimPrefix(query, "?")
+	if params, _ := url.ParseQuery(query); params["page"] == nil {
+		query = withPage(query, 0)
+	} else {
+		query = "?" + query
+	}
 	req, err := s.client.NewRequest("GET", fmt.Sprintf("team/%s/taskTemplate%s", workspaceId, query), nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	//	fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(TaskTemplatesWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -194,14 +205,32 @@ func (s *WorkspacesService) TaskTemplates(ctx context.Context, workspaceId strin
 	return wResp, resp, nil
 }
 
+// AllTaskTemplates requests pages of task templates until an empty one is
+// returned and merges them.
+func (s *WorkspacesService) AllTaskTemplates(ctx context.Context, workspaceId string) (*TaskTemplatesWrapper, *Response, error) {
+	ctx, span := s.client.StartSpan(ctx, "clickup.Workspaces.AllTaskTemplates", Attribute{AttrWorkspaceID, workspaceId})
+	defer span.End()
+
+	all := new(TaskTemplatesWrapper)
+	for page := 0; ; page++ {
+		wResp, resp, err := s.TaskTemplates(ctx, workspaceId, withPage("", page))
+		if err != nil {
+			span.RecordError(err)
+			return nil, resp, err
+		}
+		if len(wResp.TaskTemplates) == 0 {
+			return all, resp, nil
+		}
+		all.TaskTemplates = append(all.TaskTemplates, wResp.TaskTemplates...)
+	}
+}
+
 func (s *WorkspacesService) Webhooks(ctx context.Context, workspaceId string, query string) (*WebhooksWrapper, *Response, error) {
 	req, err := s.client.NewRequest("GET", fmt.Sprintf("team/%s/webhook%s", workspaceId, query), nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	//	fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(WebhooksWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -217,8 +246,6 @@ func (s *WorkspacesService) SharedHierarchy(ctx context.Context, workspaceId str
 		return nil, nil, err
 	}
 
-	//	fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(SharedHierarchy)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -234,8 +261,6 @@ func (s *WorkspacesService) Views(ctx context.Context, workspaceID string, query
 		return nil, nil, err
 	}
 
-	//fmt.Println(fmt.Sprintf("%+v", req))
-
 	wResp := new(ViewsWrapper)
 	resp, err := s.client.Do(ctx, req, wResp)
 	if err != nil {
@@ -0,0 +1,125 @@
+package clickup_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/catdevman/go-clickup/clickup/clickuptest"
+)
+
+func TestTaskTemplates(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	srv.PageSize = 2
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		srv.AddTaskTemplate(team, name, clickuptest.Task{})
+	}
+	other := srv.AddWorkspace("Other")
+	srv.AddTaskTemplate(other, "f", clickuptest.Task{})
+	srv.AddListTemplate(team, "g")
+	ctx := context.Background()
+
+	all, _, err := client.Workspaces.AllTaskTemplates(ctx, team)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, tp := range all.TaskTemplates {
+		if tp.ID == "" {
+			t.Errorf("template %q has no ID", tp.Name)
+		}
+		names = append(names, tp.Name)
+	}
+	if len(names) != 5 || names[0] != "a" || names[4] != "e" {
+		t.Errorf("AllTaskTemplates returned %q, want a to e", names)
+	}
+
+	for query, want := range map[string]string{
+		"":           "page=0",
+		"?":          "page=0",
+		"space_id=1": "space_id=1&page=0",
+		"?subpage=2": "subpage=2&page=0",
+		"?page=2":    "page=2",
+		"page=1":     "page=1",
+	} {
+		wResp, resp, err := client.Workspaces.TaskTemplates(ctx, team, query)
+		if err != nil {
+			t.Fatalf("TaskTemplates(%q): %v", query, err)
+		}
+		if got := resp.Request.URL.RawQuery; got != want {
+			t.Errorf("TaskTemplates(%q) requested %q, want %q", query, got, want)
+		}
+		if query == "?page=2" && (len(wResp.TaskTemplates) != 1 || wResp.TaskTemplates[0].Name != "e") {
+			t.Errorf("TaskTemplates(%q) = %+v, want e", query, wResp.TaskTemplates)
+		}
+	}
+}
+
+func TestCreateFromTemplate(t *testing.T) {
+	srv, client, team := setup(t)
+	defer srv.Close()
+	space := srv.AddSpace(team, "Engineering")
+	folder := srv.AddFolder(space, "Projects")
+	list := srv.AddList(space, folder, "Backlog")
+	taskTemplate := srv.AddTaskTemplate(team, "Bug", clickuptest.Task{Description: "Steps to reproduce", Status: "in progress"})
+	listTemplate := srv.AddListTemplate(team, "Sprint", clickuptest.Task{Name: "Plan"}, clickuptest.Task{Name: "Review"})
+	folderTemplate := srv.AddFolderTemplate(team, "Project", "Backlog", "Done")
+	ctx := context.Background()
+
+	task, _, err := client.Tasks.CreateFromTemplate(ctx, list, taskTemplate, "Crash on start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.ID == "" || task.Name != "Crash on start" || task.Description != "Steps to reproduce" || task.Status.Status != "in progress" {
+		t.Errorf("task from template = %+v", task)
+	}
+	if _, _, err := client.Tasks.CreateFromTemplate(ctx, list, listTemplate, "Wrong kind"); err == nil {
+		t.Error("creating a task from a list template succeeded")
+	}
+	if _, _, err := client.Tasks.CreateFromTemplate(ctx, list, taskTemplate, ""); err == nil {
+		t.Error("creating a task without a name succeeded")
+	}
+
+	l, _, err := client.Lists.CreateFromTemplate(ctx, folder, listTemplate, "Sprint 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tasks, _, err := client.Tasks.List(ctx, l.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Name != "Sprint 1" || len(tasks.Tasks) != 2 || tasks.Tasks[0].Name != "Plan" {
+		t.Errorf("list %q from template has tasks %+v, want Plan and Review", l.Name, tasks.Tasks)
+	}
+	lists, _, err := client.Lists.GetFolderLists(ctx, folder, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lists.Lists) != 2 {
+		t.Errorf("folder has %d lists, want 2", len(lists.Lists))
+	}
+
+	l, _, err = client.Lists.CreateFolderlessFromTemplate(ctx, space, listTemplate, "Sprint 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lists, _, err = client.Lists.GetFolderlessLists(ctx, space, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lists.Lists) != 1 || lists.Lists[0].ID != l.ID || lists.Lists[0].Name != "Sprint 2" {
+		t.Errorf("folderless lists = %+v, want Sprint 2", lists.Lists)
+	}
+
+	f, _, err := client.Folders.CreateFromTemplate(ctx, space, folderTemplate, "Website")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.ID == "" || f.Name != "Website" || len(f.Lists) != 2 || f.Lists[0].Name != "Backlog" || f.Lists[1].Name != "Done" {
+		t.Errorf("folder from template = %+v", f)
+	}
+	other := srv.AddFolderTemplate(srv.AddWorkspace("Other"), "Elsewhere")
+	if _, _, err := client.Folders.CreateFromTemplate(ctx, space, other, "Website"); err == nil {
+		t.Error("creating a folder from a template of another workspace succeeded")
+	}
+}